@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/indexer"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/chaincontract"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncstatus"
+)
+
+// listContracts 已注册的合约及其同步进度
+func (s *Server) listContracts(w http.ResponseWriter, r *http.Request) {
+	contracts, err := chaincontract.List(r.Context(), s.db)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	res := make([]contractResponse, 0, len(contracts))
+	for _, c := range contracts {
+		item := contractResponse{
+			ChainID:         c.ChainID,
+			ContractName:    c.ContractName,
+			ContractAddress: c.ContractAddress,
+			SyncMode:        c.SyncMode,
+		}
+		if m, ok := indexer.Lookup(c.ContractName); ok {
+			item.Module = m.Name()
+		}
+		status, err := syncstatus.GetByContractAndChain(r.Context(), s.db, c.ContractAddress, c.ChainID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			writeError(w, r, err)
+			return
+		}
+		if status != nil {
+			item.LastSyncedBlock = status.LastSyncedBlock
+		}
+		res = append(res, item)
+	}
+	httpx.OkJsonCtx(r.Context(), w, listResponse{List: res, Total: int64(len(res))})
+}
+
+// errChainRequired 合约地址注册在多条链上，请求需要指定 chain_id
+var errChainRequired = errors.New("contract is registered on multiple chains, chain_id is required")
+
+// resolveChainID 返回请求的合约所在的链：指定 chainID 时校验合约已在该链注册，
+// 未指定时合约地址只能注册在一条链上。合约未注册时返回 gorm.ErrRecordNotFound
+func (s *Server) resolveChainID(ctx context.Context, contract string, chainID int32) (int32, error) {
+	contracts, err := chaincontract.ListByAddress(ctx, s.db, contract)
+	if err != nil {
+		return 0, err
+	}
+	if chainID != 0 {
+		for _, c := range contracts {
+			if c.ChainID == chainID {
+				return chainID, nil
+			}
+		}
+		return 0, gorm.ErrRecordNotFound
+	}
+	switch len(contracts) {
+	case 0:
+		return 0, gorm.ErrRecordNotFound
+	case 1:
+		return contracts[0].ChainID, nil
+	default:
+		return 0, errChainRequired
+	}
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/stake"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractevents"
+)
+
+// listEvents 分页查询合约事件，可按用户、资金池、事件名称和区块范围过滤
+func (s *Server) listEvents(w http.ResponseWriter, r *http.Request) {
+	var req eventsRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	contract, err := parseAddress("contract", req.Contract)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	if req.Page < 1 || req.PageSize < 1 || req.PageSize > s.maxPageSize {
+		badRequest(w, r, fmt.Errorf("page must be >= 1 and page_size must be in [1, %d]", s.maxPageSize))
+		return
+	}
+	if req.ToBlock > 0 && req.ToBlock < req.FromBlock {
+		badRequest(w, r, fmt.Errorf("to_block %d is before from_block %d", req.ToBlock, req.FromBlock))
+		return
+	}
+
+	chainID, err := s.resolveChainID(r.Context(), contract, req.ChainID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	filter, err := eventFilter(chainID, contract, req.User, req.PoolID, req.Event)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	filter.FromBlock = req.FromBlock
+	filter.ToBlock = req.ToBlock
+	filter.Desc = req.Order == "desc"
+
+	events, total, err := contractevents.List(r.Context(), s.db, filter, (req.Page-1)*req.PageSize, req.PageSize)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	httpx.OkJsonCtx(r.Context(), w, listResponse{List: events, Total: total, Page: req.Page, PageSize: req.PageSize})
+}
+
+// eventFilter 按事件名称（多个以逗号分隔）、用户和资金池（-1 表示不限）构造事件过滤条件
+func eventFilter(chainID int32, contract, user string, poolID int32, event string) (contractevents.ListFilter, error) {
+	filter := contractevents.ListFilter{ChainID: chainID, ContractAddress: contract}
+	for _, name := range strings.Split(event, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			filter.EventNames = append(filter.EventNames, name)
+		}
+	}
+
+	if user == "" && poolID < 0 {
+		return filter, nil
+	}
+	var userTopic string
+	if user != "" {
+		address, err := parseAddress("user", user)
+		if err != nil {
+			return filter, err
+		}
+		userTopic = stake.AddressTopic(address)
+	}
+	var poolTopic string
+	if poolID >= 0 {
+		poolTopic = stake.PoolTopic(poolID)
+	}
+	filter.TopicMatches = topicMatches(userTopic, poolTopic)
+	return filter, nil
+}
+
+// topicMatches 把用户和资金池条件转换为按 topic 位置分组的事件过滤条件，不含所需参数的事件被排除
+func topicMatches(userTopic, poolTopic string) []contractevents.TopicMatch {
+	groups := stake.EventsWithParams(userTopic != "", poolTopic != "")
+	res := make([]contractevents.TopicMatch, 0, len(groups))
+	for params, names := range groups {
+		m := contractevents.TopicMatch{EventNames: names}
+		setTopic(&m, params.User, userTopic)
+		setTopic(&m, params.Pool, poolTopic)
+		res = append(res, m)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].EventNames[0] < res[j].EventNames[0] })
+	return res
+}
+
+func setTopic(m *contractevents.TopicMatch, index int, topic string) {
+	if topic == "" {
+		return
+	}
+	switch index {
+	case 1:
+		m.Topic1 = topic
+	case 2:
+		m.Topic2 = topic
+	}
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/graphql"
+)
+
+// graphqlRequest GraphQL over HTTP 的请求参数
+type graphqlRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// graphqlQuery 执行 GraphQL 查询，POST 读取 JSON 请求体，GET 读取 query/operationName/variables 查询参数
+func (s *Server) graphqlQuery(w http.ResponseWriter, r *http.Request) {
+	var req graphqlRequest
+	if r.Method == http.MethodGet {
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				badRequest(w, r, fmt.Errorf("invalid variables: %w", err))
+				return
+			}
+		}
+	} else {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			badRequest(w, r, fmt.Errorf("invalid request body: %w", err))
+			return
+		}
+	}
+	if req.Query == "" {
+		badRequest(w, r, fmt.Errorf("query is required"))
+		return
+	}
+
+	// 字段错误按 GraphQL 约定在响应体的 errors 中返回，状态码仍为 200
+	httpx.OkJsonCtx(r.Context(), w, s.graphql.Exec(r.Context(), req.Query, req.OperationName, req.Variables))
+}
+
+// graphqlSchema 返回 SDL 格式的模式定义，与内省查询（__schema / __type）的结果一致
+func (s *Server) graphqlSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(graphql.SDL))
+}
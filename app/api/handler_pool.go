@@ -0,0 +1,61 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+)
+
+// listPools 合约下的全部资金池
+func (s *Server) listPools(w http.ResponseWriter, r *http.Request) {
+	var req contractRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	contract, err := parseAddress("contract", req.Contract)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	chainID, err := s.resolveChainID(r.Context(), contract, req.ChainID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	pools, err := poolinfo.ListByContract(r.Context(), s.db, chainID, contract)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	httpx.OkJsonCtx(r.Context(), w, listResponse{List: pools, Total: int64(len(pools))})
+}
+
+// getPool 单个资金池
+func (s *Server) getPool(w http.ResponseWriter, r *http.Request) {
+	var req poolRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	contract, err := parseAddress("contract", req.Contract)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	chainID, err := s.resolveChainID(r.Context(), contract, req.ChainID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	pool, err := poolinfo.GetByPoolIDAndContract(r.Context(), s.db, chainID, req.PoolID, contract)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	httpx.OkJsonCtx(r.Context(), w, pool)
+}
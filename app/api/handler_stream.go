@@ -0,0 +1,391 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-redis/redis/v8"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/indexer"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncblocks"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncstatus"
+)
+
+const (
+	defaultStreamPollInterval = 15 * time.Second
+	streamBatchSize           = 100
+)
+
+type streamRequest struct {
+	Contract  string `path:"contract"`
+	ChainID   int32  `form:"chain_id,optional"`
+	User      string `form:"user,optional"`
+	PoolID    int32  `form:"pool_id,default=-1"`
+	Event     string `form:"event,optional"`
+	After     string `form:"after,optional"`      // 续传游标，即最后收到的事件 id（block:logIndex:blockHash），Last-Event-ID 请求头优先
+	FromBlock uint64 `form:"from_block,optional"` // 没有游标时从该区块开始推送，0 表示只推送连接之后的新事件
+}
+
+// streamCursor 推送位置：已推送到 (block, logIndex)，logIndex 为 -1 表示 block 区块的事件都未推送。
+// hash 为 block 的区块哈希，续传时用于判断断线期间该区块是否被重组；区块已超出重组窗口时为空
+type streamCursor struct {
+	block    uint64
+	logIndex int32
+	hash     string
+}
+
+func (c streamCursor) String() string {
+	if c.hash == "" {
+		return fmt.Sprintf("%d:%d", c.block, c.logIndex)
+	}
+	return fmt.Sprintf("%d:%d:%s", c.block, c.logIndex, c.hash)
+}
+
+// parseStreamCursor 解析 block:logIndex[:blockHash]，不带区块哈希的游标不做重组校验
+func parseStreamCursor(s string) (streamCursor, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) == 2 || len(parts) == 3 {
+		b, errB := strconv.ParseUint(parts[0], 10, 64)
+		l, errL := strconv.ParseInt(parts[1], 10, 32)
+		if errB == nil && errL == nil && l >= -1 {
+			cursor := streamCursor{block: b, logIndex: int32(l)}
+			if len(parts) == 2 {
+				return cursor, nil
+			}
+			if hash, err := hexutil.Decode(parts[2]); err == nil && len(hash) == ethCommon.HashLength {
+				cursor.hash = ethCommon.BytesToHash(hash).Hex()
+				return cursor, nil
+			}
+		}
+	}
+	return streamCursor{}, fmt.Errorf("invalid event id %q, expected block:logIndex:blockHash", s)
+}
+
+// streamEvents 以 SSE 推送合约新提交的事件，可按用户、资金池和事件名称过滤。
+// 每条事件的 id 为 block:logIndex:blockHash，断线重连时通过 Last-Event-ID 或 after 从该位置之后续传；
+// 链重组删除已推送的事件时（包括断线期间发生的重组）发送 rollback 事件，客户端应丢弃 block 之后收到的事件
+func (s *Server) streamEvents(w http.ResponseWriter, r *http.Request) {
+	var req streamRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	contract, err := parseAddress("contract", req.Contract)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		req.After = id
+	}
+	chainID, err := s.resolveChainID(r.Context(), contract, req.ChainID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	filter, err := eventFilter(chainID, contract, req.User, req.PoolID, req.Event)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	cursor, err := s.streamStart(r.Context(), chainID, contract, req)
+	if err != nil {
+		if errors.Is(err, errInvalidCursor) {
+			badRequest(w, r, err)
+		} else {
+			writeError(w, r, err)
+		}
+		return
+	}
+
+	// 先注册再查询，避免查询与注册之间提交的事件漏掉唤醒
+	sub := s.hub.subscribe(chainID, contract)
+	defer s.hub.unsubscribe(chainID, contract, sub)
+
+	rc := http.NewResponseController(w)
+	w.WriteHeader(http.StatusOK)
+	ticker := time.NewTicker(s.streamPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// 重组通知可能丢失，且断线期间发生的重组没有通知，因此每次推送前都按区块哈希校验游标
+		ancestor, ok := sub.takeRollback()
+		if !ok || ancestor >= cursor.block {
+			ancestor, ok, err = s.checkCursor(r.Context(), chainID, contract, cursor)
+			if err != nil {
+				logx.Error(fmt.Sprintf("streamEvents: %s: check cursor error: %v", contract, err))
+				return
+			}
+		}
+		if ok {
+			cursor = s.rollbackCursor(r.Context(), chainID, contract, ancestor)
+			if err := writeSSE(w, cursor.String(), "rollback", map[string]uint64{"block": ancestor}); err != nil {
+				return
+			}
+		}
+		if err := s.sendEvents(r.Context(), w, chainID, filter, &cursor); err != nil {
+			if r.Context().Err() == nil {
+				logx.Error(fmt.Sprintf("streamEvents: %s: %v", contract, err))
+			}
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-sub.wake:
+		case <-ticker.C:
+			// 心跳注释行，防止代理关闭空闲连接
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+	}
+}
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// streamStart 确定推送起点：续传游标 > from_block > 合约当前最新事件
+func (s *Server) streamStart(ctx context.Context, chainID int32, contract string, req streamRequest) (streamCursor, error) {
+	if req.After != "" {
+		cursor, err := parseStreamCursor(req.After)
+		if err != nil {
+			return cursor, fmt.Errorf("%w: %v", errInvalidCursor, err)
+		}
+		return cursor, nil
+	}
+	if req.FromBlock > 0 {
+		return streamCursor{block: req.FromBlock, logIndex: -1}, nil
+	}
+	last, err := contractevents.GetLast(ctx, s.db, chainID, contract)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return streamCursor{logIndex: -1}, nil
+	}
+	if err != nil {
+		return streamCursor{}, err
+	}
+	cursor := streamCursor{block: last.BlockNumber, logIndex: last.LogIndex}
+	if block, err := syncblocks.GetByBlockNumber(ctx, s.db, chainID, contract, last.BlockNumber); err == nil {
+		cursor.hash = block.BlockHash
+	}
+	return cursor, nil
+}
+
+// checkCursor 校验游标所在区块是否仍在当前链上，不在时返回需要回滚到的区块。
+// 只知道游标区块被重组时，无法确定断线期间重组的公共祖先，按重组窗口保守回滚：
+// 游标区块之前 MaxReorgDepth 个区块之外的区块不会被回滚
+func (s *Server) checkCursor(ctx context.Context, chainID int32, contract string, cursor streamCursor) (uint64, bool, error) {
+	if cursor.hash == "" {
+		return 0, false, nil
+	}
+	block, err := syncblocks.GetByBlockNumber(ctx, s.db, chainID, contract, cursor.block)
+	if err == nil {
+		if block.BlockHash == cursor.hash {
+			return 0, false, nil
+		}
+	} else if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 区块哈希不存在：超出重组窗口被清理（不可逆）或被回滚后尚未重新同步
+		status, err := syncstatus.GetByContractAndChain(ctx, s.db, contract, chainID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, false, err
+		}
+		if status != nil && cursor.block+indexer.MaxReorgDepth <= status.LastSyncedBlock {
+			return 0, false, nil
+		}
+	} else {
+		return 0, false, err
+	}
+	if cursor.block < indexer.MaxReorgDepth {
+		return 0, true, nil
+	}
+	return cursor.block - indexer.MaxReorgDepth, true, nil
+}
+
+// rollbackCursor 回滚后的游标：ancestor 区块及之前的事件都已推送
+func (s *Server) rollbackCursor(ctx context.Context, chainID int32, contract string, ancestor uint64) streamCursor {
+	cursor := streamCursor{block: ancestor, logIndex: math.MaxInt32}
+	if block, err := syncblocks.GetByBlockNumber(ctx, s.db, chainID, contract, ancestor); err == nil {
+		cursor.hash = block.BlockHash
+	}
+	return cursor
+}
+
+// sendEvents 推送游标之后的全部事件并推进游标
+func (s *Server) sendEvents(ctx context.Context, w http.ResponseWriter, chainID int32, filter contractevents.ListFilter, cursor *streamCursor) error {
+	for {
+		var events []*model.ContractEvent
+		hashes := make(map[uint64]string)
+		// 事件与区块哈希在同一事务中读取，保证读到的是同一次提交后的数据
+		err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var err error
+			events, err = contractevents.ListAfter(ctx, tx, filter, cursor.block, cursor.logIndex, streamBatchSize)
+			if err != nil {
+				return err
+			}
+			var numbers []uint64
+			for _, ev := range events {
+				if len(numbers) == 0 || numbers[len(numbers)-1] != ev.BlockNumber {
+					numbers = append(numbers, ev.BlockNumber)
+				}
+			}
+			blocks, err := syncblocks.ListByBlockNumbers(ctx, tx, chainID, filter.ContractAddress, numbers)
+			if err != nil {
+				return err
+			}
+			for _, b := range blocks {
+				hashes[b.BlockNumber] = b.BlockHash
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("list events error: %w", err)
+		}
+		for _, ev := range events {
+			next := streamCursor{block: ev.BlockNumber, logIndex: ev.LogIndex, hash: hashes[ev.BlockNumber]}
+			if err := writeSSE(w, next.String(), "", ev); err != nil {
+				return err
+			}
+			*cursor = next
+		}
+		if len(events) < streamBatchSize {
+			return nil
+		}
+	}
+}
+
+// writeSSE 写入一条 SSE 消息，event 为空时客户端按默认的 message 事件处理
+func writeSSE(w http.ResponseWriter, id, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var sb strings.Builder
+	sb.WriteString("id: " + id + "\n")
+	if event != "" {
+		sb.WriteString("event: " + event + "\n")
+	}
+	sb.WriteString("data: ")
+	sb.Write(payload)
+	sb.WriteString("\n\n")
+	_, err = fmt.Fprint(w, sb.String())
+	return err
+}
+
+// eventHub 订阅同步任务发布的事件通知，唤醒对应合约的推送连接。
+// 通知只用于及时唤醒，连接始终从数据库按游标读取事件，通知丢失时由兜底轮询补上
+type eventHub struct {
+	mu   sync.Mutex
+	subs map[hubKey]map[*streamSub]struct{}
+}
+
+// hubKey 订阅按链和小写合约地址索引
+type hubKey struct {
+	chainID  int32
+	contract string
+}
+
+type streamSub struct {
+	wake chan struct{}
+
+	mu       sync.Mutex
+	rollback *uint64 // 尚未处理的回滚中最小的公共祖先
+}
+
+func newEventHub() *eventHub {
+	return &eventHub{subs: make(map[hubKey]map[*streamSub]struct{})}
+}
+
+func (h *eventHub) subscribe(chainID int32, contract string) *streamSub {
+	sub := &streamSub{wake: make(chan struct{}, 1)}
+	key := hubKey{chainID: chainID, contract: strings.ToLower(contract)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subs[key] == nil {
+		h.subs[key] = make(map[*streamSub]struct{})
+	}
+	h.subs[key][sub] = struct{}{}
+	return sub
+}
+
+func (h *eventHub) unsubscribe(chainID int32, contract string, sub *streamSub) {
+	key := hubKey{chainID: chainID, contract: strings.ToLower(contract)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs[key], sub)
+	if len(h.subs[key]) == 0 {
+		delete(h.subs, key)
+	}
+}
+
+func (h *eventHub) dispatch(n indexer.EventsNotification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs[hubKey{chainID: n.ChainID, contract: strings.ToLower(n.Contract)}] {
+		if n.Rollback {
+			sub.mu.Lock()
+			if sub.rollback == nil || n.Block < *sub.rollback {
+				block := n.Block
+				sub.rollback = &block
+			}
+			sub.mu.Unlock()
+		}
+		select {
+		case sub.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// takeRollback 取出并清除待处理的回滚
+func (sub *streamSub) takeRollback() (uint64, bool) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if sub.rollback == nil {
+		return 0, false
+	}
+	block := *sub.rollback
+	sub.rollback = nil
+	return block, true
+}
+
+// run 订阅 Redis 通知频道直到 ctx 结束，断线后由 Redis 客户端自动重连
+func (h *eventHub) run(ctx context.Context, client *redis.Client) {
+	ps := client.Subscribe(ctx, indexer.EventsChannel)
+	defer ps.Close()
+
+	ch := ps.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			var n indexer.EventsNotification
+			if err := json.Unmarshal([]byte(msg.Payload), &n); err != nil {
+				logx.Error(fmt.Sprintf("eventHub: invalid notification %q: %v", msg.Payload, err))
+				continue
+			}
+			h.dispatch(n)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	testContract = "0x01c7A3d0e3CE1Be6e6FD19dC0A3A4d2B6a9E0C01"
+	testHashA    = "0x1111111111111111111111111111111111111111111111111111111111111111"
+	testHashB    = "0x2222222222222222222222222222222222222222222222222222222222222222"
+)
+
+func TestParseStreamCursor(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    streamCursor
+		wantErr bool
+	}{
+		{in: "100:3", want: streamCursor{block: 100, logIndex: 3}},
+		{in: "100:-1", want: streamCursor{block: 100, logIndex: -1}},
+		{in: "100:3:" + testHashA, want: streamCursor{block: 100, logIndex: 3, hash: testHashA}},
+		{in: "100:3:0x1111111111111111111111111111111111111111111111111111111111111111", want: streamCursor{block: 100, logIndex: 3, hash: testHashA}},
+		{in: "100", wantErr: true},
+		{in: "100:-2", wantErr: true},
+		{in: "x:1", wantErr: true},
+		{in: "100:3:0x1234", wantErr: true},
+		{in: "100:3:" + testHashA + ":1", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseStreamCursor(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseStreamCursor(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseStreamCursor(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+		if !tt.wantErr && got.String() != tt.in {
+			t.Errorf("String() = %q, want %q", got.String(), tt.in)
+		}
+	}
+}
+
+func TestCheckCursor(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range []string{
+		`CREATE TABLE sync_blocks (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, block_number INT, block_hash TEXT, parent_hash TEXT, created_at DATETIME)`,
+		`CREATE TABLE sync_status (id INTEGER PRIMARY KEY AUTOINCREMENT, contract_address TEXT, chain_id INT, last_synced_block INT, last_sync_time DATETIME, sync_error TEXT, is_syncing BOOL, created_at DATETIME, updated_at DATETIME)`,
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	db.Exec(`INSERT INTO sync_status (contract_address, chain_id, last_synced_block) VALUES (?, 1, 1000)`, testContract)
+	db.Exec(`INSERT INTO sync_blocks (chain_id, contract_address, block_number, block_hash, parent_hash) VALUES (1, ?, 990, ?, ''), (1, ?, 995, ?, '')`,
+		testContract, testHashA, testContract, testHashB)
+
+	s := &Server{db: db}
+	tests := []struct {
+		name         string
+		cursor       streamCursor
+		wantRollback bool
+		wantAncestor uint64
+	}{
+		{"no hash", streamCursor{block: 990, logIndex: 1}, false, 0},
+		{"same hash", streamCursor{block: 990, logIndex: 1, hash: testHashA}, false, 0},
+		{"reorged block", streamCursor{block: 995, logIndex: 0, hash: testHashA}, true, 995 - 128},
+		{"rolled back block", streamCursor{block: 998, logIndex: 0, hash: testHashA}, true, 998 - 128},
+		{"beyond stored head", streamCursor{block: 1005, logIndex: 0, hash: testHashA}, true, 1005 - 128},
+		{"pruned final block", streamCursor{block: 800, logIndex: 0, hash: testHashA}, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ancestor, rollback, err := s.checkCursor(context.Background(), 1, testContract, tt.cursor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rollback != tt.wantRollback || ancestor != tt.wantAncestor {
+				t.Errorf("checkCursor() = %d, %v, want %d, %v", ancestor, rollback, tt.wantAncestor, tt.wantRollback)
+			}
+		})
+	}
+
+	// 回滚游标带上公共祖先的区块哈希，续传时可以继续校验
+	if got := s.rollbackCursor(context.Background(), 1, testContract, 990); got.String() != "990:2147483647:"+testHashA {
+		t.Errorf("rollbackCursor() = %s", got)
+	}
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/unstakerequests"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+)
+
+// listPositions 用户在合约各资金池中的质押和奖励状态，待领取奖励按同步进度计算
+func (s *Server) listPositions(w http.ResponseWriter, r *http.Request) {
+	var req userRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	contract, user, err := parseContractAndUser(req.Contract, req.User)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	chainID, err := s.resolveChainID(r.Context(), contract, req.ChainID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	positions, err := userpoolstats.ListByUserAndContract(r.Context(), s.db, chainID, user, contract)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if err := reward.RefreshPending(r.Context(), s.db, chainID, contract, positions); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	httpx.OkJsonCtx(r.Context(), w, listResponse{List: positions, Total: int64(len(positions))})
+}
+
+// listUnstakeRequests 用户的解质押请求，按申请顺序排列
+func (s *Server) listUnstakeRequests(w http.ResponseWriter, r *http.Request) {
+	var req unstakeRequestsRequest
+	if err := httpx.Parse(r, &req); err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	contract, user, err := parseContractAndUser(req.Contract, req.User)
+	if err != nil {
+		badRequest(w, r, err)
+		return
+	}
+	chainID, err := s.resolveChainID(r.Context(), contract, req.ChainID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	filter := unstakerequests.ListFilter{ChainID: chainID, UserAddress: user, ContractAddress: contract}
+	if req.PoolID >= 0 {
+		filter.PoolID = &req.PoolID
+	}
+	switch req.Status {
+	case "pending":
+		withdrawn := false
+		filter.IsWithdrawn = &withdrawn
+	case "withdrawn":
+		withdrawn := true
+		filter.IsWithdrawn = &withdrawn
+	}
+
+	requests, err := unstakerequests.List(r.Context(), s.db, filter)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	httpx.OkJsonCtx(r.Context(), w, listResponse{List: requests, Total: int64(len(requests))})
+}
+
+func parseContractAndUser(contract, user string) (string, string, error) {
+	contract, err := parseAddress("contract", contract)
+	if err != nil {
+		return "", "", err
+	}
+	user, err = parseAddress("user", user)
+	if err != nil {
+		return "", "", err
+	}
+	return contract, user, nil
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/rest"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/graphql"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+)
+
+const defaultMaxPageSize = 100
+
+// Server 只读查询 API，直接读取同步任务写入的表，不修改数据
+type Server struct {
+	db                 *gorm.DB
+	redis              *redis.Client // 接收同步任务的事件通知，为 nil 时事件推送只靠轮询
+	maxPageSize        int
+	streamPollInterval time.Duration
+	graphql            *graphqlgo.Schema
+	hub                *eventHub
+	server             *rest.Server
+	ctx                context.Context
+	cancel             context.CancelFunc
+}
+
+func New(cfg *config.APIConfig, db *gorm.DB, redisClient *redis.Client) (*Server, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("New: api config is missing")
+	}
+
+	// 未配置的项使用 go-zero 的默认值
+	var c rest.RestConf
+	if err := conf.FillDefault(&c); err != nil {
+		return nil, fmt.Errorf("New: fill rest config error: %w", err)
+	}
+	c.Name = "StakeSyncAPI"
+	c.Host = cfg.Host
+	c.Port = cfg.Port
+	if cfg.Timeout > 0 {
+		c.Timeout = cfg.Timeout.Milliseconds()
+	}
+
+	server, err := rest.NewServer(c)
+	if err != nil {
+		return nil, fmt.Errorf("New: create rest server error: %w", err)
+	}
+
+	s := &Server{
+		db:                 db,
+		redis:              redisClient,
+		maxPageSize:        cfg.MaxPageSize,
+		streamPollInterval: cfg.StreamPollInterval,
+		graphql:            graphql.NewSchema(db),
+		hub:                newEventHub(),
+		server:             server,
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	if s.maxPageSize <= 0 {
+		s.maxPageSize = defaultMaxPageSize
+	}
+	if s.streamPollInterval <= 0 {
+		s.streamPollInterval = defaultStreamPollInterval
+	}
+	s.routes()
+	return s, nil
+}
+
+// Start 启动 HTTP 服务，阻塞直到 Stop 被调用
+func (s *Server) Start() {
+	if s.redis != nil {
+		go s.hub.run(s.ctx, s.redis)
+	}
+	s.server.Start()
+}
+
+func (s *Server) Stop() {
+	s.cancel()
+	s.server.Stop()
+}
+
+func (s *Server) routes() {
+	s.server.AddRoutes([]rest.Route{
+		{Method: http.MethodGet, Path: "/api/v1/contracts", Handler: s.listContracts},
+		{Method: http.MethodGet, Path: "/api/v1/contracts/:contract/pools", Handler: s.listPools},
+		{Method: http.MethodGet, Path: "/api/v1/contracts/:contract/pools/:pool_id", Handler: s.getPool},
+		{Method: http.MethodGet, Path: "/api/v1/contracts/:contract/users/:user/positions", Handler: s.listPositions},
+		{Method: http.MethodGet, Path: "/api/v1/contracts/:contract/users/:user/unstake-requests", Handler: s.listUnstakeRequests},
+		{Method: http.MethodGet, Path: "/api/v1/contracts/:contract/events", Handler: s.listEvents},
+	})
+	// 事件推送是长连接，不受请求超时限制（客户端需带 Accept: text/event-stream 请求头）
+	s.server.AddRoutes([]rest.Route{
+		{Method: http.MethodGet, Path: "/api/v1/contracts/:contract/stream", Handler: s.streamEvents},
+	}, rest.WithSSE())
+	s.server.AddRoutes([]rest.Route{
+		{Method: http.MethodGet, Path: "/graphql", Handler: s.graphqlQuery},
+		{Method: http.MethodPost, Path: "/graphql", Handler: s.graphqlQuery},
+		{Method: http.MethodGet, Path: "/graphql/schema", Handler: s.graphqlSchema},
+	})
+}
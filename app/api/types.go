@@ -0,0 +1,98 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"gorm.io/gorm"
+)
+
+// 合约相关请求的 chain_id 可选，未指定时按合约地址确定所在的链，见 resolveChainID
+
+type contractRequest struct {
+	Contract string `path:"contract"`
+	ChainID  int32  `form:"chain_id,optional"`
+}
+
+type poolRequest struct {
+	Contract string `path:"contract"`
+	PoolID   int32  `path:"pool_id"`
+	ChainID  int32  `form:"chain_id,optional"`
+}
+
+type userRequest struct {
+	Contract string `path:"contract"`
+	User     string `path:"user"`
+	ChainID  int32  `form:"chain_id,optional"`
+}
+
+type unstakeRequestsRequest struct {
+	Contract string `path:"contract"`
+	User     string `path:"user"`
+	ChainID  int32  `form:"chain_id,optional"`
+	PoolID   int32  `form:"pool_id,default=-1"`                               // -1 表示全部资金池
+	Status   string `form:"status,default=all,options=all|pending|withdrawn"` // 提现状态
+}
+
+type eventsRequest struct {
+	Contract  string `path:"contract"`
+	ChainID   int32  `form:"chain_id,optional"`
+	User      string `form:"user,optional"`                       // 用户地址，只匹配含用户参数的事件
+	PoolID    int32  `form:"pool_id,default=-1"`                  // 资金池ID，-1 表示不限，只匹配含资金池参数的事件
+	Event     string `form:"event,optional"`                      // 事件名称，多个以逗号分隔
+	FromBlock uint64 `form:"from_block,optional"`                 // 起始区块（包含）
+	ToBlock   uint64 `form:"to_block,optional"`                   // 结束区块（包含），0 表示不限
+	Order     string `form:"order,default=desc,options=asc|desc"` // 按 (区块号, 日志序号) 排序
+	Page      int    `form:"page,default=1"`
+	PageSize  int    `form:"page_size,default=20"`
+}
+
+type contractResponse struct {
+	ChainID         int32  `json:"chain_id"`
+	ContractName    int32  `json:"contract_name"`
+	Module          string `json:"module"`
+	ContractAddress string `json:"contract_address"`
+	SyncMode        string `json:"sync_mode"`
+	LastSyncedBlock uint64 `json:"last_synced_block"`
+}
+
+type listResponse struct {
+	List     interface{} `json:"list"`
+	Total    int64       `json:"total"`
+	Page     int         `json:"page,omitempty"`
+	PageSize int         `json:"page_size,omitempty"`
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+// parseAddress 校验十六进制地址并转换为同步任务入库时使用的 checksum 格式
+func parseAddress(name, address string) (string, error) {
+	if !ethCommon.IsHexAddress(address) {
+		return "", fmt.Errorf("invalid %s address: %s", name, address)
+	}
+	return ethCommon.HexToAddress(address).Hex(), nil
+}
+
+func badRequest(w http.ResponseWriter, r *http.Request, err error) {
+	httpx.WriteJsonCtx(r.Context(), w, http.StatusBadRequest, errorResponse{Message: err.Error()})
+}
+
+// writeError 记录未找到返回 404，未指定链返回 400，其余数据库错误记录日志后返回 500，不向客户端暴露细节
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, errChainRequired) {
+		badRequest(w, r, err)
+		return
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		httpx.WriteJsonCtx(r.Context(), w, http.StatusNotFound, errorResponse{Message: "not found"})
+		return
+	}
+	logx.WithContext(r.Context()).Error(fmt.Sprintf("api %s %s error: %v", r.Method, r.URL.Path, err))
+	httpx.WriteJsonCtx(r.Context(), w, http.StatusInternalServerError, errorResponse{Message: "internal error"})
+}
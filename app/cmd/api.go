@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/api"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+	"github.com/spf13/cobra"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var APICmd = &cobra.Command{
+	Use:   "api",
+	Short: "Start the read-only query API",
+	Long:  `Start the read-only REST API serving pools, user positions, unstake requests and event history from the synced database.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.UnmarshalCmdConfig()
+		if err != nil {
+			return fmt.Errorf("unmarshal config error: %w", err)
+		}
+		logx.MustSetup(cfg.Log)
+
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			logx.Errorf("Failed to connect database: %v", err)
+			return err
+		}
+
+		server, err := api.New(cfg.API, db, service.NewRedis(cfg))
+		if err != nil {
+			logx.Errorf("Failed to create api server: %v", err)
+			return err
+		}
+
+		// 收到退出信号后停止服务，Start 随之返回
+		onSignal := make(chan os.Signal, 1)
+		signal.Notify(onSignal, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			sig := <-onSignal
+			logx.Infof("Exit by signal: %s", sig.String())
+			server.Stop()
+		}()
+
+		logx.Info(fmt.Sprintf("api server listening on %s:%d", cfg.API.Host, cfg.API.Port))
+		server.Start()
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(APICmd)
+}
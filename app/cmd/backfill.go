@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+	"github.com/spf13/cobra"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	backfillChainID  int32
+	backfillContract string
+	backfillFrom     uint64
+	backfillTo       uint64
+	backfillChunk    uint64
+	backfillWorkers  int
+	backfillReindex  bool
+)
+
+var BackfillCmd = &cobra.Command{
+	Use:   "backfill",
+	Short: "Backfill historical contract events for a block range",
+	Long: `Fetch logs and block headers for [from, to] concurrently and apply them to the database in (block, logIndex) order.
+--from must not be later than the block after the sync checkpoint (or the first block to sync when nothing is synced yet),
+otherwise the skipped blocks would never be processed.
+Logs that were already processed are skipped, so backfilling an already synced range changes nothing.
+To process synced blocks again (e.g. after fixing a handler), use --reindex: blocks from --from on are rebuilt up to the
+current sync checkpoint (--to is not allowed). When --from is within the last 128 synced blocks, events and derived rows
+written from --from on are rolled back like a chain reorg using the reorg snapshots. For older blocks no snapshots are kept,
+so the contract's derived tables, events and block hashes are cleared, the sync checkpoint is reset to the deployment block
+and the contract is rebuilt from its first block.
+The command holds the contract's sync lease while running and fails if the daemon for the same contract is running.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if backfillReindex && backfillTo != 0 {
+			return fmt.Errorf("--to cannot be used with --reindex, re-indexing always rebuilds up to the sync checkpoint")
+		}
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+		defer cancel()
+
+		cfg, err := config.UnmarshalCmdConfig()
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+		logx.MustSetup(cfg.Log)
+
+		s, err := service.New(ctx, cfg)
+		if err != nil {
+			return err
+		}
+
+		chainID, contract := backfillChainID, backfillContract
+		if contract == "" {
+			// 只注册了一个合约时可省略 --chain-id/--contract
+			contracts := s.Contracts()
+			if len(contracts) != 1 {
+				return fmt.Errorf("%d contracts registered, specify --chain-id and --contract", len(contracts))
+			}
+			chainID, contract = contracts[0].ChainID, contracts[0].Address
+		}
+
+		begin := time.Now()
+		if backfillReindex {
+			if err := s.Reindex(ctx, chainID, contract, backfillFrom, backfillChunk, backfillWorkers); err != nil {
+				return err
+			}
+			fmt.Printf("reindex finished in %s\n", time.Since(begin).Round(time.Second))
+			return nil
+		}
+		if err := s.Backfill(ctx, chainID, contract, backfillFrom, backfillTo, backfillChunk, backfillWorkers); err != nil {
+			return err
+		}
+		fmt.Printf("backfill finished in %s\n", time.Since(begin).Round(time.Second))
+		return nil
+	},
+}
+
+func init() {
+	flags := BackfillCmd.Flags()
+	flags.Int32Var(&backfillChainID, "chain-id", 0, "chain id of the contract (default: the only registered contract)")
+	flags.StringVar(&backfillContract, "contract", "", "address of the contract (default: the only registered contract)")
+	flags.Uint64Var(&backfillFrom, "from", 0, "first block to backfill")
+	flags.Uint64Var(&backfillTo, "to", 0, "last block to backfill (default: current sync height)")
+	flags.Uint64Var(&backfillChunk, "chunk", 0, "blocks per eth_getLogs request (default: sync.max_block_range)")
+	flags.IntVar(&backfillWorkers, "workers", 4, "number of concurrent fetch workers")
+	flags.BoolVar(&backfillReindex, "reindex", false, "process synced blocks from --from to the sync checkpoint again (rebuilds from the first block when --from is older than 128 blocks)")
+	_ = BackfillCmd.MarkFlagRequired("from")
+
+	rootCmd.AddCommand(BackfillCmd)
+}
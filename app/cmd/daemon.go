@@ -25,6 +25,7 @@ var DaemonCmd = &cobra.Command{
 		wg.Add(1)
 		ctx := context.Background()
 		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
 
 		onSyncExit := make(chan error, 1)
 
@@ -48,7 +49,13 @@ var DaemonCmd = &cobra.Command{
 				return
 			}
 
-			s.Start()
+			started, err := s.Start()
+			if err != nil {
+				logx.Error("Failed to start sync tasks", zap.Error(err))
+				onSyncExit <- err
+				return
+			}
+			logx.Info(fmt.Sprintf("%d sync task(s) started", started))
 
 			if cfg.Monitor.PprofEnable { // 开启pprof，用于性能监控
 				srv := &http.Server{Addr: fmt.Sprintf("0.0.0.0:%d", cfg.Monitor.PprofPort)}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/failedevents"
+	"github.com/spf13/cobra"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	dlqListStatus string
+	dlqListLimit  int
+	dlqRetryAll   bool
+	dlqRetryLimit int
+)
+
+var DlqCmd = &cobra.Command{
+	Use:   "dlq",
+	Short: "Manage failed events in the dead-letter queue",
+	Long: `List, retry or drop events whose handlers kept failing and were moved to the failed_events table.
+Syncing of a contract halts while it has a pending failed event.`,
+}
+
+var dlqListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List failed events",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+
+		events, err := failedevents.List(ctx, db, dlqListStatus, dlqListLimit)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSTATUS\tATTEMPTS\tEVENT\tHANDLER\tBLOCK\tTX\tLOG\tERROR")
+		for _, fe := range events {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\t%s\t%d\t%s\t%d\t%s\n",
+				fe.ID, fe.Status, fe.Attempts, fe.EventName, fe.HandlerName, fe.BlockNumber,
+				fe.TransactionHash, fe.LogIndex, truncate(fe.ErrorMessage, 80))
+		}
+		return w.Flush()
+	},
+}
+
+var dlqRetryCmd = &cobra.Command{
+	Use:   "retry [id...]",
+	Short: "Resume syncing and process failed events again",
+	Long: `Mark the given pending events for retry. Use --all to retry every pending event.
+A pending event halts syncing of its contract; once it is marked for retry the daemon resumes
+and processes the range containing the event again in (block, logIndex) order.
+The event is resolved when the range commits, or pending again if it keeps failing.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if !dlqRetryAll && len(args) == 0 {
+			return fmt.Errorf("specify event ids or --all")
+		}
+		ids, err := parseIDs(args)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+
+		if dlqRetryAll {
+			events, err := failedevents.List(ctx, db, failedevents.StatusPending, dlqRetryLimit)
+			if err != nil {
+				return err
+			}
+			for _, fe := range events {
+				ids = append(ids, fe.ID)
+			}
+		}
+
+		retrying, err := failedevents.MarkRetrying(ctx, db, ids)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("marked %d event(s) for retry\n", retrying)
+		return nil
+	},
+}
+
+var dlqDropCmd = &cobra.Command{
+	Use:   "drop id...",
+	Short: "Drop failed events and resume syncing without them",
+	Long: `Mark the given events as dropped. The daemon resumes syncing and skips the handlers of dropped events,
+so the derived tables will not reflect them. Use only when the event can safely be ignored.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids, err := parseIDs(args)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+
+		dropped, err := failedevents.MarkDropped(ctx, db, ids)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("dropped %d event(s)\n", dropped)
+		return nil
+	},
+}
+
+func loadCmdConfig() (*config.Config, error) {
+	cfg, err := config.UnmarshalCmdConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	logx.MustSetup(cfg.Log)
+	return cfg, nil
+}
+
+func parseIDs(args []string) ([]int64, error) {
+	ids := make([]int64, 0, len(args))
+	for _, arg := range args {
+		id, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q", arg)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
+
+func init() {
+	dlqListCmd.Flags().StringVar(&dlqListStatus, "status", failedevents.StatusPending, "filter by status (pending / retrying / resolved / dropped), empty for all")
+	dlqListCmd.Flags().IntVar(&dlqListLimit, "limit", 100, "maximum number of events to list")
+	dlqRetryCmd.Flags().BoolVar(&dlqRetryAll, "all", false, "retry all pending events")
+	dlqRetryCmd.Flags().IntVar(&dlqRetryLimit, "limit", 100, "maximum number of events to retry with --all")
+
+	DlqCmd.AddCommand(dlqListCmd, dlqRetryCmd, dlqDropCmd)
+	rootCmd.AddCommand(DlqCmd)
+}
@@ -0,0 +1,370 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/stake"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/webhook"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/webhookdeliveries"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/webhooksubscriptions"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"gorm.io/gorm"
+)
+
+var (
+	webhookName      string
+	webhookURL       string
+	webhookSecret    string
+	webhookFormat    string
+	webhookChainID   int32
+	webhookContract  string
+	webhookEvents    string
+	webhookAdmin     bool
+	webhookPoolID    int32
+	webhookMinAmount string
+
+	webhookDeliverySubscription int64
+	webhookDeliveryStatus       string
+	webhookDeliveryLimit        int
+)
+
+var WebhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Manage webhook subscriptions and deliveries",
+	Long: `Register HTTP endpoints that receive HMAC-signed JSON (or Slack-style alerts) for stake events matching a filter,
+and inspect or replay the delivery log. Deliveries are sent by the daemon when webhook.enabled is set.`,
+}
+
+var webhookAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a webhook subscription",
+	Example: `  # Deposits of at least 1e18 raw units into pool 0
+  webhook add --name big-deposits --url https://example.com/hook --events Deposit --pool-id 0 --min-amount 1000000000000000000
+  # Slack alert for every admin function call, e.g. setMetaNodePerBlock or pauseWithdraw
+  webhook add --name ops --url https://hooks.slack.com/services/... --format slack --admin`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		u, err := url.Parse(webhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid --url %q, expected an http(s) URL", webhookURL)
+		}
+		if webhookFormat != webhooksubscriptions.FormatJSON && webhookFormat != webhooksubscriptions.FormatSlack {
+			return fmt.Errorf("invalid --format %q, expected %s or %s", webhookFormat, webhooksubscriptions.FormatJSON, webhooksubscriptions.FormatSlack)
+		}
+		if webhookContract != "" && !ethCommon.IsHexAddress(webhookContract) {
+			return fmt.Errorf("invalid --contract %q", webhookContract)
+		}
+		patterns := webhookEvents
+		if webhookAdmin {
+			patterns = strings.Join(append([]string{patterns}, stake.AdminEvents...), ",")
+		}
+		events, err := webhook.ParseEvents(patterns)
+		if err != nil {
+			return err
+		}
+
+		sub := &model.WebhookSubscription{
+			Name:            webhookName,
+			URL:             webhookURL,
+			Secret:          webhookSecret,
+			Format:          webhookFormat,
+			ChainID:         webhookChainID,
+			ContractAddress: webhookContract,
+			Events:          events,
+			Enabled:         true,
+		}
+		if cmd.Flags().Changed("pool-id") {
+			sub.PoolID = &webhookPoolID
+		}
+		if webhookMinAmount != "" {
+			amount, err := types.ParseBigInt(webhookMinAmount)
+			if err != nil {
+				return fmt.Errorf("invalid --min-amount: %w", err)
+			}
+			sub.MinAmount = &amount
+		}
+		if sub.Secret == "" {
+			if sub.Secret, err = newWebhookSecret(); err != nil {
+				return err
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+		if err := webhooksubscriptions.Create(ctx, db, sub); err != nil {
+			return err
+		}
+		fmt.Printf("created webhook subscription %d\n", sub.ID)
+		if webhookSecret == "" {
+			fmt.Printf("signing secret: %s\n", sub.Secret)
+		}
+		return nil
+	},
+}
+
+var webhookListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List webhook subscriptions",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+
+		subs, err := webhooksubscriptions.List(ctx, db)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tENABLED\tFORMAT\tCHAIN\tCONTRACT\tEVENTS\tPOOL\tMIN AMOUNT\tURL")
+		for _, sub := range subs {
+			chain, contract, events, pool, minAmount := "*", "*", "*", "*", "-"
+			if sub.ChainID != 0 {
+				chain = strconv.Itoa(int(sub.ChainID))
+			}
+			if sub.ContractAddress != "" {
+				contract = sub.ContractAddress
+			}
+			if sub.Events != "" {
+				events = sub.Events
+			}
+			if sub.PoolID != nil {
+				pool = strconv.Itoa(int(*sub.PoolID))
+			}
+			if sub.MinAmount != nil {
+				minAmount = sub.MinAmount.String()
+			}
+			fmt.Fprintf(w, "%d\t%s\t%t\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				sub.ID, sub.Name, sub.Enabled, sub.Format, chain, contract, truncate(events, 60), pool, minAmount, sub.URL)
+		}
+		return w.Flush()
+	},
+}
+
+var webhookEnableCmd = &cobra.Command{
+	Use:   "enable id...",
+	Short: "Enable webhook subscriptions",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setWebhooksEnabled(args, true)
+	},
+}
+
+var webhookDisableCmd = &cobra.Command{
+	Use:   "disable id...",
+	Short: "Disable webhook subscriptions",
+	Long:  `Stop matching new events for the given subscriptions. Pending deliveries are kept and sent after the subscription is enabled again.`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setWebhooksEnabled(args, false)
+	},
+}
+
+var webhookRemoveCmd = &cobra.Command{
+	Use:   "remove id...",
+	Short: "Remove webhook subscriptions together with their delivery log",
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids, err := parseIDs(args)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+
+		var removed int64
+		err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			for _, id := range ids {
+				n, err := webhooksubscriptions.Delete(ctx, tx, id)
+				if err != nil {
+					return err
+				}
+				if err := webhookdeliveries.DeleteBySubscription(ctx, tx, id); err != nil {
+					return err
+				}
+				removed += n
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("removed %d subscription(s)\n", removed)
+		return nil
+	},
+}
+
+var webhookDeliveriesCmd = &cobra.Command{
+	Use:   "deliveries",
+	Short: "List webhook deliveries, newest first",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+
+		deliveries, err := webhookdeliveries.List(ctx, db, webhookDeliverySubscription, webhookDeliveryStatus, webhookDeliveryLimit)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tSUBSCRIPTION\tSTATUS\tATTEMPTS\tHTTP\tEVENT\tBLOCK\tTX\tLOG\tNEXT RETRY\tERROR")
+		for _, d := range deliveries {
+			httpStatus, nextRetry, lastError := "-", "-", ""
+			if d.ResponseStatus != nil {
+				httpStatus = strconv.Itoa(int(*d.ResponseStatus))
+			}
+			if d.NextRetryAt != nil {
+				nextRetry = d.NextRetryAt.Format(time.DateTime)
+			}
+			if d.LastError != nil {
+				lastError = *d.LastError
+			}
+			fmt.Fprintf(w, "%d\t%d\t%s\t%d\t%s\t%s\t%d\t%s\t%d\t%s\t%s\n",
+				d.ID, d.SubscriptionID, d.Status, d.Attempts, httpStatus, d.EventName, d.BlockNumber,
+				d.TransactionHash, d.LogIndex, nextRetry, truncate(lastError, 80))
+		}
+		return w.Flush()
+	},
+}
+
+var webhookRedeliverCmd = &cobra.Command{
+	Use:   "redeliver id...",
+	Short: "Send deliveries again",
+	Long:  `Reset the given deliveries (failed or already delivered) so the daemon sends them again on its next poll.`,
+	Args:  cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ids, err := parseIDs(args)
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		cfg, err := loadCmdConfig()
+		if err != nil {
+			return err
+		}
+		db, err := service.NewDB(cfg)
+		if err != nil {
+			return err
+		}
+
+		n, err := webhookdeliveries.Redeliver(ctx, db, ids)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("scheduled %d delivery(s)\n", n)
+		return nil
+	},
+}
+
+func setWebhooksEnabled(args []string, enabled bool) error {
+	ids, err := parseIDs(args)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cfg, err := loadCmdConfig()
+	if err != nil {
+		return err
+	}
+	db, err := service.NewDB(cfg)
+	if err != nil {
+		return err
+	}
+
+	var updated int64
+	for _, id := range ids {
+		n, err := webhooksubscriptions.SetEnabled(ctx, db, id, enabled)
+		if err != nil {
+			return err
+		}
+		updated += n
+	}
+	fmt.Printf("updated %d subscription(s)\n", updated)
+	return nil
+}
+
+// newWebhookSecret 生成随机签名密钥
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate secret error: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func init() {
+	flags := webhookAddCmd.Flags()
+	flags.StringVar(&webhookName, "name", "", "name of the subscription")
+	flags.StringVar(&webhookURL, "url", "", "endpoint that receives POST requests")
+	flags.StringVar(&webhookSecret, "secret", "", "HMAC signing secret (default: randomly generated and printed)")
+	flags.StringVar(&webhookFormat, "format", webhooksubscriptions.FormatJSON, "payload format (json / slack)")
+	flags.Int32Var(&webhookChainID, "chain-id", 0, "only match events on this chain (default: any chain)")
+	flags.StringVar(&webhookContract, "contract", "", "only match events of this contract (default: any contract)")
+	flags.StringVar(&webhookEvents, "events", "", "comma-separated event name patterns, * matches any characters, e.g. Deposit,Pause* (default: all events)")
+	flags.BoolVar(&webhookAdmin, "admin", false, "also match events emitted by admin functions, e.g. SetMetaNodePerBlock, PauseWithdraw")
+	flags.Int32Var(&webhookPoolID, "pool-id", 0, "only match events of this pool")
+	flags.StringVar(&webhookMinAmount, "min-amount", "", "only match events whose amount is at least this value, in raw on-chain units")
+	_ = webhookAddCmd.MarkFlagRequired("name")
+	_ = webhookAddCmd.MarkFlagRequired("url")
+
+	webhookDeliveriesCmd.Flags().Int64Var(&webhookDeliverySubscription, "subscription", 0, "filter by subscription id")
+	webhookDeliveriesCmd.Flags().StringVar(&webhookDeliveryStatus, "status", "", "filter by status (pending / delivered / failed), empty for all")
+	webhookDeliveriesCmd.Flags().IntVar(&webhookDeliveryLimit, "limit", 100, "maximum number of deliveries to list")
+
+	WebhookCmd.AddCommand(webhookAddCmd, webhookListCmd, webhookEnableCmd, webhookDisableCmd, webhookRemoveCmd, webhookDeliveriesCmd, webhookRedeliverCmd)
+	rootCmd.AddCommand(WebhookCmd)
+}
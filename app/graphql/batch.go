@@ -0,0 +1,52 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// batchRow UNION ALL 查询的结果行，BatchIndex 为行所属查询的序号
+type batchRow[T any] struct {
+	BatchIndex int `gorm:"column:batch_index"`
+	Row        T   `gorm:"embedded"`
+}
+
+// findBatch 执行多个同表查询，按查询分组返回结果。多个查询通过 UNION ALL 合并为一次数据库往返，
+// 各查询自身的排序和 LIMIT 在子查询中生效，合并后的顺序不保证，每组结果按 less 重新排序
+func findBatch[T any](ctx context.Context, db *gorm.DB, queries []*gorm.DB, less func(a, b *T) bool) ([][]*T, error) {
+	res := make([][]*T, len(queries))
+	if len(queries) == 0 {
+		return res, nil
+	}
+	if len(queries) == 1 {
+		if err := queries[0].Find(&res[0]).Error; err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+
+	var sql strings.Builder
+	args := make([]interface{}, len(queries))
+	for i, q := range queries {
+		if i > 0 {
+			sql.WriteString(" UNION ALL ")
+		}
+		fmt.Fprintf(&sql, "SELECT %d AS batch_index, t%d.* FROM (?) AS t%d", i, i, i)
+		args[i] = q
+	}
+	var rows []batchRow[T]
+	if err := db.WithContext(ctx).Raw(sql.String(), args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		res[rows[i].BatchIndex] = append(res[rows[i].BatchIndex], &rows[i].Row)
+	}
+	for _, group := range res {
+		sort.SliceStable(group, func(i, j int) bool { return less(group[i], group[j]) })
+	}
+	return res, nil
+}
@@ -0,0 +1,152 @@
+package graphql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const maxPageSize = 100
+
+// connection Relay 风格的分页结果
+type connection[T any] struct {
+	edges       []edge[T]
+	hasNextPage bool
+}
+
+type edge[T any] struct {
+	cursor string
+	node   T
+}
+
+// page 游标分页参数：after 之后的 first 条
+type page struct {
+	first int
+	after string
+}
+
+// pageArgs 列表字段的分页参数，first 的默认值见 schema.graphql
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+func (a pageArgs) page() (page, error) {
+	pg := page{first: int(a.First)}
+	if pg.first < 1 || pg.first > maxPageSize {
+		return page{}, fmt.Errorf("argument \"first\" must be in [1, %d]", maxPageSize)
+	}
+	if a.After != nil {
+		pg.after = *a.After
+	}
+	return pg, nil
+}
+
+// newConnection rows 为按游标顺序多查询一条的结果，多出的一条只用于判断是否还有下一页
+func newConnection[T any](rows []T, p page, cursor func(T) string) *connection[T] {
+	res := &connection[T]{edges: make([]edge[T], 0, len(rows))}
+	if len(rows) > p.first {
+		rows = rows[:p.first]
+		res.hasNextPage = true
+	}
+	for _, row := range rows {
+		res.edges = append(res.edges, edge[T]{cursor: cursor(row), node: row})
+	}
+	return res
+}
+
+// encodeCursor 把排序键编码为不透明游标，kind 用于拒绝其他列表的游标
+func encodeCursor(kind string, keys ...uint64) string {
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, kind)
+	for _, k := range keys {
+		parts = append(parts, strconv.FormatUint(k, 10))
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, ":")))
+}
+
+func decodeCursor(kind, cursor string, n int) ([]uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != n+1 || parts[0] != kind {
+		return nil, fmt.Errorf("invalid cursor %q", cursor)
+	}
+	res := make([]uint64, n)
+	for i := range res {
+		if res[i], err = strconv.ParseUint(parts[i+1], 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid cursor %q", cursor)
+		}
+	}
+	return res, nil
+}
+
+// connectionResolver <name>Connection 类型，N 为节点的解析器
+type connectionResolver[N any] struct {
+	edges       []*edgeResolver[N]
+	hasNextPage bool
+}
+
+func (c *connectionResolver[N]) Edges() []*edgeResolver[N] {
+	return c.edges
+}
+
+func (c *connectionResolver[N]) Nodes() []N {
+	nodes := make([]N, len(c.edges))
+	for i, e := range c.edges {
+		nodes[i] = e.node
+	}
+	return nodes
+}
+
+func (c *connectionResolver[N]) PageInfo() *pageInfoResolver {
+	res := &pageInfoResolver{hasNextPage: c.hasNextPage}
+	if len(c.edges) > 0 {
+		res.endCursor = &c.edges[len(c.edges)-1].cursor
+	}
+	return res
+}
+
+// edgeResolver <name>Edge 类型
+type edgeResolver[N any] struct {
+	cursor string
+	node   N
+}
+
+func (e *edgeResolver[N]) Cursor() string {
+	return e.cursor
+}
+
+func (e *edgeResolver[N]) Node() N {
+	return e.node
+}
+
+type pageInfoResolver struct {
+	hasNextPage bool
+	endCursor   *string
+}
+
+func (p *pageInfoResolver) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+func (p *pageInfoResolver) EndCursor() *string {
+	return p.endCursor
+}
+
+// newConnections 把各父对象的分页结果转换为连接，所有连接中的节点组成下一层，嵌套字段按层批量查询
+func newConnections[T, N any](r *resolver, conns []*connection[T], wrap func(node[T]) N) []*connectionResolver[N] {
+	l := newLayer[T](r)
+	res := make([]*connectionResolver[N], len(conns))
+	for i, c := range conns {
+		res[i] = &connectionResolver[N]{edges: make([]*edgeResolver[N], 0, len(c.edges)), hasNextPage: c.hasNextPage}
+		for _, e := range c.edges {
+			res[i].edges = append(res[i].edges, &edgeResolver[N]{cursor: e.cursor, node: wrap(node[T]{l: l, i: len(l.rows)})})
+			l.rows = append(l.rows, e.node)
+		}
+	}
+	return res
+}
@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"sync"
+)
+
+// layer 查询结果中的同一层对象，例如各资金池的持仓列表中的全部持仓。嵌套字段在第一个对象解析时为整层一起查询，
+// 结果按字段和参数缓存，同层其他对象直接取对应的结果，查询次数只与嵌套层数有关，与列表长度无关
+type layer[T any] struct {
+	r    *resolver
+	rows []T
+
+	mu    sync.Mutex
+	loads map[string]*layerLoad
+}
+
+type layerLoad struct {
+	once sync.Once
+	res  interface{}
+	err  error
+}
+
+func newLayer[T any](r *resolver, rows ...T) *layer[T] {
+	return &layer[T]{r: r, rows: rows, loads: make(map[string]*layerLoad)}
+}
+
+// node 层中的一个对象，各类型的解析器通过嵌入 node 访问所在的层
+type node[T any] struct {
+	l *layer[T]
+	i int
+}
+
+func (n node[T]) row() T {
+	return n.l.rows[n.i]
+}
+
+// batchLoad 同一层中 key 相同的字段只执行一次 fetch，返回第 n 个对象的结果。fetch 的结果与 rows 一一对应，
+// 同一层的字段可能并发解析，其他对象等待第一次 fetch 完成
+func batchLoad[T, R any](n node[T], key string, fetch func(rows []T) ([]R, error)) (R, error) {
+	l := n.l
+	l.mu.Lock()
+	ld, ok := l.loads[key]
+	if !ok {
+		ld = &layerLoad{}
+		l.loads[key] = ld
+	}
+	l.mu.Unlock()
+
+	ld.once.Do(func() {
+		res, err := fetch(l.rows)
+		ld.res, ld.err = res, err
+	})
+	if ld.err != nil {
+		var zero R
+		return zero, ld.err
+	}
+	return ld.res.([]R)[n.i], nil
+}
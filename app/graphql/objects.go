@@ -0,0 +1,417 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/stake"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+// bigInt BigInt 标量，输出为十进制字符串
+type bigInt struct {
+	types.BigInt
+}
+
+func (bigInt) ImplementsGraphQLType(name string) bool {
+	return name == "BigInt"
+}
+
+// UnmarshalGraphQL BigInt 目前只用于输出，输入时接受十进制字符串
+func (b *bigInt) UnmarshalGraphQL(input interface{}) error {
+	s, ok := input.(string)
+	if !ok {
+		return fmt.Errorf("BigInt must be a decimal string, got %T", input)
+	}
+	v, err := types.ParseBigInt(s)
+	if err != nil {
+		return err
+	}
+	b.BigInt = v
+	return nil
+}
+
+func optBigInt(v *types.BigInt) *bigInt {
+	if v == nil {
+		return nil
+	}
+	return &bigInt{*v}
+}
+
+func optInt(v *uint64) *int32 {
+	if v == nil {
+		return nil
+	}
+	res := int32(*v)
+	return &res
+}
+
+// poolResolver Pool 类型，嵌套列表为同一层的所有资金池一起查询
+type poolResolver struct {
+	node[*model.PoolInfo]
+}
+
+func newPool(n node[*model.PoolInfo]) *poolResolver {
+	return &poolResolver{n}
+}
+
+func (p *poolResolver) ID() graphqlgo.ID {
+	row := p.row()
+	return graphqlgo.ID(fmt.Sprintf("%d-%s-%d", row.ChainID, row.ContractAddress, row.PoolID))
+}
+
+func (p *poolResolver) ChainID() int32            { return p.row().ChainID }
+func (p *poolResolver) Contract() string          { return p.row().ContractAddress }
+func (p *poolResolver) PoolID() int32             { return p.row().PoolID }
+func (p *poolResolver) StTokenAddress() string    { return p.row().StTokenAddress }
+func (p *poolResolver) PoolWeight() bigInt        { return bigInt{p.row().PoolWeight} }
+func (p *poolResolver) TotalPoolWeight() *bigInt  { return optBigInt(p.row().TotalPoolWeight) }
+func (p *poolResolver) LastRewardBlock() int32    { return int32(p.row().LastRewardBlock) }
+func (p *poolResolver) AccMetaNodePerST() *bigInt { return optBigInt(p.row().AccMetanodePerSt) }
+func (p *poolResolver) StTokenAmount() *bigInt    { return optBigInt(p.row().StTokenAmount) }
+func (p *poolResolver) MinDepositAmount() bigInt  { return bigInt{p.row().MinDepositAmount} }
+func (p *poolResolver) UnstakeLockedBlocks() int32 {
+	return p.row().UnstakeLockedBlocks
+}
+func (p *poolResolver) IsActive() *bool      { return p.row().IsActive }
+func (p *poolResolver) CreatedBlock() *int32 { return optInt(p.row().CreatedBlock) }
+func (p *poolResolver) CreatedTx() *string   { return p.row().CreatedTx }
+
+func (p *poolResolver) Positions(ctx context.Context, args positionsArgs) (*connectionResolver[*positionResolver], error) {
+	if args.Where != nil && args.Where.PoolID != nil {
+		return nil, nestedFieldError("poolId")
+	}
+	f, err := args.Where.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	return batchLoad(p.node, loadKey("positions", args), func(pools []*model.PoolInfo) ([]*connectionResolver[*positionResolver], error) {
+		filters := make([]positionFilter, len(pools))
+		for i, pool := range pools {
+			filters[i] = f
+			filters[i].contract, filters[i].poolID = poolContract(pool), &pool.PoolID
+		}
+		conns, err := p.l.r.positions(ctx, filters, pg)
+		if err != nil {
+			return nil, internal(err)
+		}
+		return newConnections(p.l.r, conns, newPosition), nil
+	})
+}
+
+func (p *poolResolver) UnstakeRequests(ctx context.Context, args unstakeRequestsArgs) (*connectionResolver[*unstakeRequestResolver], error) {
+	if args.Where != nil && args.Where.PoolID != nil {
+		return nil, nestedFieldError("poolId")
+	}
+	f, err := args.Where.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	return batchLoad(p.node, loadKey("unstakeRequests", args), func(pools []*model.PoolInfo) ([]*connectionResolver[*unstakeRequestResolver], error) {
+		filters := make([]unstakeRequestFilter, len(pools))
+		for i, pool := range pools {
+			filters[i] = f
+			filters[i].contract, filters[i].poolID = poolContract(pool), &pool.PoolID
+		}
+		conns, err := p.l.r.unstakeRequests(ctx, filters, pg)
+		if err != nil {
+			return nil, internal(err)
+		}
+		return newConnections(p.l.r, conns, newUnstakeRequest), nil
+	})
+}
+
+func (p *poolResolver) Events(ctx context.Context, args eventsArgs) (*connectionResolver[*eventResolver], error) {
+	if args.Where != nil && args.Where.PoolID != nil {
+		return nil, nestedFieldError("poolId")
+	}
+	f, err := args.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	return batchLoad(p.node, loadKey("events", args), func(pools []*model.PoolInfo) ([]*connectionResolver[*eventResolver], error) {
+		filters := make([]eventFilter, len(pools))
+		for i, pool := range pools {
+			filters[i] = f
+			filters[i].contract, filters[i].poolID = poolContract(pool), &pool.PoolID
+		}
+		conns, err := p.l.r.events(ctx, filters, pg)
+		if err != nil {
+			return nil, internal(err)
+		}
+		return newConnections(p.l.r, conns, newEvent), nil
+	})
+}
+
+// userResolver User 类型，用户数据分散在多张表中，嵌套字段为同一层的所有用户一起查询
+type userResolver struct {
+	node[*user]
+}
+
+func (u *userResolver) ID() graphqlgo.ID {
+	row := u.row()
+	return graphqlgo.ID(fmt.Sprintf("%d-%s-%s", row.contract.chainID, row.contract.address, row.address))
+}
+
+func (u *userResolver) ChainID() int32   { return u.row().contract.chainID }
+func (u *userResolver) Contract() string { return u.row().contract.address }
+func (u *userResolver) Address() string  { return u.row().address }
+
+func (u *userResolver) Positions(ctx context.Context) ([]*positionResolver, error) {
+	return batchLoad(u.node, "positions", func(users []*user) ([][]*positionResolver, error) {
+		groups, err := u.l.r.userPositions(ctx, users)
+		if err != nil {
+			return nil, internal(err)
+		}
+		l := newLayer[*model.UserPoolStat](u.l.r)
+		res := make([][]*positionResolver, len(groups))
+		for i, rows := range groups {
+			res[i] = make([]*positionResolver, 0, len(rows))
+			for _, row := range rows {
+				res[i] = append(res[i], newPosition(node[*model.UserPoolStat]{l: l, i: len(l.rows)}))
+				l.rows = append(l.rows, row)
+			}
+		}
+		return res, nil
+	})
+}
+
+func (u *userResolver) UnstakeRequests(ctx context.Context, args unstakeRequestsArgs) (*connectionResolver[*unstakeRequestResolver], error) {
+	if args.Where != nil && args.Where.User != nil {
+		return nil, nestedFieldError("user")
+	}
+	f, err := args.Where.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	return batchLoad(u.node, loadKey("unstakeRequests", args), func(users []*user) ([]*connectionResolver[*unstakeRequestResolver], error) {
+		filters := make([]unstakeRequestFilter, len(users))
+		for i, usr := range users {
+			filters[i] = f
+			filters[i].contract, filters[i].user = usr.contract, usr.address
+		}
+		conns, err := u.l.r.unstakeRequests(ctx, filters, pg)
+		if err != nil {
+			return nil, internal(err)
+		}
+		return newConnections(u.l.r, conns, newUnstakeRequest), nil
+	})
+}
+
+func (u *userResolver) Events(ctx context.Context, args eventsArgs) (*connectionResolver[*eventResolver], error) {
+	if args.Where != nil && args.Where.User != nil {
+		return nil, nestedFieldError("user")
+	}
+	f, err := args.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	return batchLoad(u.node, loadKey("events", args), func(users []*user) ([]*connectionResolver[*eventResolver], error) {
+		filters := make([]eventFilter, len(users))
+		for i, usr := range users {
+			filters[i] = f
+			filters[i].contract, filters[i].user = usr.contract, usr.address
+		}
+		conns, err := u.l.r.events(ctx, filters, pg)
+		if err != nil {
+			return nil, internal(err)
+		}
+		return newConnections(u.l.r, conns, newEvent), nil
+	})
+}
+
+// positionResolver Position 类型
+type positionResolver struct {
+	node[*model.UserPoolStat]
+}
+
+func newPosition(n node[*model.UserPoolStat]) *positionResolver {
+	return &positionResolver{n}
+}
+
+func (s *positionResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(strconv.FormatInt(s.row().ID, 10))
+}
+
+func (s *positionResolver) PoolID() int32             { return s.row().PoolID }
+func (s *positionResolver) StAmount() *bigInt         { return optBigInt(s.row().StAmount) }
+func (s *positionResolver) FinishedMetaNode() *bigInt { return optBigInt(s.row().FinishedMetanode) }
+func (s *positionResolver) PendingMetaNode() *bigInt  { return optBigInt(s.row().PendingMetanode) }
+func (s *positionResolver) TotalDeposited() *bigInt   { return optBigInt(s.row().TotalDeposited) }
+func (s *positionResolver) TotalUnstaked() *bigInt    { return optBigInt(s.row().TotalUnstaked) }
+func (s *positionResolver) TotalWithdrawn() *bigInt   { return optBigInt(s.row().TotalWithdrawn) }
+func (s *positionResolver) TotalClaimed() *bigInt     { return optBigInt(s.row().TotalClaimed) }
+func (s *positionResolver) LastDepositBlock() *int32  { return optInt(s.row().LastDepositBlock) }
+func (s *positionResolver) LastClaimBlock() *int32    { return optInt(s.row().LastClaimBlock) }
+
+func (s *positionResolver) User() *userResolver {
+	return userOf(s.node, func(row *model.UserPoolStat) *user {
+		return &user{contract: contractRef{chainID: row.ChainID, address: row.ContractAddress}, address: row.UserAddress}
+	})
+}
+
+func (s *positionResolver) Pool(ctx context.Context) (*poolResolver, error) {
+	return poolOf(ctx, s.node, func(row *model.UserPoolStat) (poolKey, bool) {
+		return poolKey{contract: contractRef{chainID: row.ChainID, address: row.ContractAddress}, poolID: row.PoolID}, true
+	})
+}
+
+// unstakeRequestResolver UnstakeRequest 类型
+type unstakeRequestResolver struct {
+	node[*model.UserUnstakeRequest]
+}
+
+func newUnstakeRequest(n node[*model.UserUnstakeRequest]) *unstakeRequestResolver {
+	return &unstakeRequestResolver{n}
+}
+
+func (u *unstakeRequestResolver) ID() graphqlgo.ID {
+	return graphqlgo.ID(strconv.FormatInt(u.row().ID, 10))
+}
+
+func (u *unstakeRequestResolver) PoolID() int32          { return u.row().PoolID }
+func (u *unstakeRequestResolver) Amount() bigInt         { return bigInt{u.row().Amount} }
+func (u *unstakeRequestResolver) UnlockBlock() int32     { return int32(u.row().UnlockBlock) }
+func (u *unstakeRequestResolver) RequestBlock() int32    { return int32(u.row().RequestBlock) }
+func (u *unstakeRequestResolver) RequestTx() string      { return u.row().RequestTx }
+func (u *unstakeRequestResolver) WithdrawnBlock() *int32 { return optInt(u.row().WithdrawnBlock) }
+func (u *unstakeRequestResolver) WithdrawnTx() *string   { return u.row().WithdrawnTx }
+
+func (u *unstakeRequestResolver) IsWithdrawn() bool {
+	w := u.row().IsWithdrawn
+	return w != nil && *w
+}
+
+func (u *unstakeRequestResolver) User() *userResolver {
+	return userOf(u.node, func(row *model.UserUnstakeRequest) *user {
+		return &user{contract: contractRef{chainID: row.ChainID, address: row.ContractAddress}, address: row.UserAddress}
+	})
+}
+
+func (u *unstakeRequestResolver) Pool(ctx context.Context) (*poolResolver, error) {
+	return poolOf(ctx, u.node, func(row *model.UserUnstakeRequest) (poolKey, bool) {
+		return poolKey{contract: contractRef{chainID: row.ChainID, address: row.ContractAddress}, poolID: row.PoolID}, true
+	})
+}
+
+// eventResolver Event 类型，user、pool 只对带有对应 indexed 参数的事件有值
+type eventResolver struct {
+	node[*model.ContractEvent]
+}
+
+func newEvent(n node[*model.ContractEvent]) *eventResolver {
+	return &eventResolver{n}
+}
+
+func (e *eventResolver) ID() graphqlgo.ID {
+	row := e.row()
+	return graphqlgo.ID(fmt.Sprintf("%s-%d", row.TransactionHash, row.LogIndex))
+}
+
+func (e *eventResolver) Name() string            { return e.row().EventName }
+func (e *eventResolver) ChainID() int32          { return e.row().ChainID }
+func (e *eventResolver) Contract() string        { return e.row().ContractAddress }
+func (e *eventResolver) BlockNumber() int32      { return int32(e.row().BlockNumber) }
+func (e *eventResolver) BlockTimestamp() int32   { return int32(e.row().BlockTimestamp) }
+func (e *eventResolver) TransactionHash() string { return e.row().TransactionHash }
+func (e *eventResolver) LogIndex() int32         { return e.row().LogIndex }
+func (e *eventResolver) Data() *string           { return e.row().Data }
+
+func (e *eventResolver) Topics() []string {
+	row := e.row()
+	topics := []string{row.Topic0}
+	for _, t := range []*string{row.Topic1, row.Topic2, row.Topic3} {
+		if t == nil {
+			break
+		}
+		topics = append(topics, *t)
+	}
+	return topics
+}
+
+func (e *eventResolver) User() *userResolver {
+	return userOf(e.node, func(row *model.ContractEvent) *user {
+		topic := eventTopic(row, stake.IndexedEventParams[row.EventName].User)
+		if topic == nil {
+			return nil
+		}
+		return &user{contract: contractRef{chainID: row.ChainID, address: row.ContractAddress}, address: stake.TopicToAddress(*topic)}
+	})
+}
+
+func (e *eventResolver) Pool(ctx context.Context) (*poolResolver, error) {
+	return poolOf(ctx, e.node, func(row *model.ContractEvent) (poolKey, bool) {
+		topic := eventTopic(row, stake.IndexedEventParams[row.EventName].Pool)
+		if topic == nil {
+			return poolKey{}, false
+		}
+		return poolKey{contract: contractRef{chainID: row.ChainID, address: row.ContractAddress}, poolID: stake.TopicToPoolID(*topic)}, true
+	})
+}
+
+// userOf 同一层对象所属的用户组成下一层，get 返回 nil 的对象没有用户
+func userOf[T any](n node[T], get func(T) *user) *userResolver {
+	res, _ := batchLoad(n, "user", func(rows []T) ([]*userResolver, error) {
+		l := newLayer[*user](n.l.r)
+		res := make([]*userResolver, len(rows))
+		for i, row := range rows {
+			if u := get(row); u != nil {
+				res[i] = &userResolver{node[*user]{l: l, i: len(l.rows)}}
+				l.rows = append(l.rows, u)
+			}
+		}
+		return res, nil
+	})
+	return res
+}
+
+// poolOf 同一层对象所属的资金池一次查询并组成下一层，key 返回 false 的对象（事件不含资金池参数）没有资金池
+func poolOf[T any](ctx context.Context, n node[T], key func(T) (poolKey, bool)) (*poolResolver, error) {
+	return batchLoad(n, "pool", func(rows []T) ([]*poolResolver, error) {
+		var keys []poolKey
+		var index []int
+		for i, row := range rows {
+			if k, ok := key(row); ok {
+				keys = append(keys, k)
+				index = append(index, i)
+			}
+		}
+		pools, err := n.l.r.poolsByKey(ctx, keys)
+		if err != nil {
+			return nil, internal(err)
+		}
+		l := newLayer[*model.PoolInfo](n.l.r)
+		res := make([]*poolResolver, len(rows))
+		for i, pool := range pools {
+			if pool != nil {
+				res[index[i]] = newPool(node[*model.PoolInfo]{l: l, i: len(l.rows)})
+				l.rows = append(l.rows, pool)
+			}
+		}
+		return res, nil
+	})
+}
@@ -0,0 +1,141 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+// queryResolver Query 类型，顶层字段的结果各自组成一层
+type queryResolver struct {
+	r *resolver
+}
+
+func (q *queryResolver) Pools(ctx context.Context, args struct {
+	contractArgs
+	pageArgs
+	Where *poolFilterInput
+}) (*connectionResolver[*poolResolver], error) {
+	contract, err := q.r.contract(ctx, args.contractArgs)
+	if err != nil {
+		return nil, err
+	}
+	f, err := args.Where.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := q.r.pools(ctx, contract, f, pg)
+	if err != nil {
+		return nil, internal(err)
+	}
+	return newConnections(q.r, []*connection[*model.PoolInfo]{conn}, newPool)[0], nil
+}
+
+func (q *queryResolver) Pool(ctx context.Context, args struct {
+	contractArgs
+	PoolID int32
+}) (*poolResolver, error) {
+	contract, err := q.r.contract(ctx, args.contractArgs)
+	if err != nil {
+		return nil, err
+	}
+	pools, err := q.r.poolsByKey(ctx, []poolKey{{contract: contract, poolID: args.PoolID}})
+	if err != nil {
+		return nil, internal(err)
+	}
+	if pools[0] == nil {
+		return nil, nil
+	}
+	return newPool(node[*model.PoolInfo]{l: newLayer(q.r, pools[0])}), nil
+}
+
+func (q *queryResolver) User(ctx context.Context, args struct {
+	contractArgs
+	Address string
+}) (*userResolver, error) {
+	contract, err := q.r.contract(ctx, args.contractArgs)
+	if err != nil {
+		return nil, err
+	}
+	address, err := addressArg("address", &args.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &userResolver{node[*user]{l: newLayer(q.r, &user{contract: contract, address: address})}}, nil
+}
+
+func (q *queryResolver) Positions(ctx context.Context, args struct {
+	contractArgs
+	positionsArgs
+}) (*connectionResolver[*positionResolver], error) {
+	contract, err := q.r.contract(ctx, args.contractArgs)
+	if err != nil {
+		return nil, err
+	}
+	f, err := args.Where.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	f.contract = contract
+	conns, err := q.r.positions(ctx, []positionFilter{f}, pg)
+	if err != nil {
+		return nil, internal(err)
+	}
+	return newConnections(q.r, conns, newPosition)[0], nil
+}
+
+func (q *queryResolver) UnstakeRequests(ctx context.Context, args struct {
+	contractArgs
+	unstakeRequestsArgs
+}) (*connectionResolver[*unstakeRequestResolver], error) {
+	contract, err := q.r.contract(ctx, args.contractArgs)
+	if err != nil {
+		return nil, err
+	}
+	f, err := args.Where.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	f.contract = contract
+	conns, err := q.r.unstakeRequests(ctx, []unstakeRequestFilter{f}, pg)
+	if err != nil {
+		return nil, internal(err)
+	}
+	return newConnections(q.r, conns, newUnstakeRequest)[0], nil
+}
+
+func (q *queryResolver) Events(ctx context.Context, args struct {
+	contractArgs
+	eventsArgs
+}) (*connectionResolver[*eventResolver], error) {
+	contract, err := q.r.contract(ctx, args.contractArgs)
+	if err != nil {
+		return nil, err
+	}
+	f, err := args.filter()
+	if err != nil {
+		return nil, err
+	}
+	pg, err := args.page()
+	if err != nil {
+		return nil, err
+	}
+	f.contract = contract
+	conns, err := q.r.events(ctx, []eventFilter{f}, pg)
+	if err != nil {
+		return nil, internal(err)
+	}
+	return newConnections(q.r, conns, newEvent)[0], nil
+}
@@ -0,0 +1,353 @@
+package graphql
+
+import (
+	"context"
+
+	genfield "gorm.io/gen/field"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/stake"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/query"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+// resolver 通过 gen 生成的 query 包读取同步数据
+type resolver struct {
+	db *gorm.DB
+	q  *query.Query
+}
+
+// contractRef 链上的合约，同一地址可能部署在多条链上
+type contractRef struct {
+	chainID int32
+	address string
+}
+
+// user User 类型的数据源，用户数据分散在多张表中，按需查询
+type user struct {
+	contract contractRef
+	address  string
+}
+
+type poolFilter struct {
+	isActive       *bool
+	stTokenAddress string
+}
+
+// poolKey 资金池在合约中的唯一标识
+type poolKey struct {
+	contract contractRef
+	poolID   int32
+}
+
+// 列表查询的条件，嵌套在资金池或用户下时每个父对象各有一个条件，一起批量查询
+
+type positionFilter struct {
+	contract contractRef
+	user     string
+	poolID   *int32
+	hasStake *bool
+}
+
+type unstakeRequestFilter struct {
+	contract    contractRef
+	user        string
+	poolID      *int32
+	isWithdrawn *bool
+}
+
+type eventFilter struct {
+	contract  contractRef
+	names     []string
+	user      string
+	poolID    *int32
+	fromBlock uint64
+	toBlock   uint64 // 0 表示不限
+	desc      bool
+}
+
+func (r *resolver) pools(ctx context.Context, contract contractRef, f poolFilter, pg page) (*connection[*model.PoolInfo], error) {
+	p := r.q.PoolInfo
+	do := p.WithContext(ctx).Where(p.ChainID.Eq(contract.chainID), p.ContractAddress.Eq(contract.address))
+	if f.isActive != nil {
+		do = do.Where(p.IsActive.Is(*f.isActive))
+	}
+	if f.stTokenAddress != "" {
+		do = do.Where(p.StTokenAddress.Eq(f.stTokenAddress))
+	}
+	if pg.after != "" {
+		keys, err := decodeCursor("pool", pg.after, 1)
+		if err != nil {
+			return nil, err
+		}
+		do = do.Where(p.PoolID.Gt(int32(keys[0])))
+	}
+	rows, err := do.Order(p.PoolID).Limit(pg.first + 1).Find()
+	if err != nil {
+		return nil, err
+	}
+	return newConnection(rows, pg, func(row *model.PoolInfo) string { return encodeCursor("pool", uint64(row.PoolID)) }), nil
+}
+
+// poolsByKey 批量读取资金池，返回值与 keys 一一对应，不存在的资金池为 nil。同一合约的资金池一次查询
+func (r *resolver) poolsByKey(ctx context.Context, keys []poolKey) ([]*model.PoolInfo, error) {
+	var contracts []contractRef
+	ids := make(map[contractRef][]int32)
+	seen := make(map[poolKey]bool)
+	for _, k := range keys {
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		if _, ok := ids[k.contract]; !ok {
+			contracts = append(contracts, k.contract)
+		}
+		ids[k.contract] = append(ids[k.contract], k.poolID)
+	}
+
+	p := r.q.PoolInfo
+	found := make(map[poolKey]*model.PoolInfo, len(seen))
+	for _, c := range contracts {
+		rows, err := p.WithContext(ctx).Where(p.ChainID.Eq(c.chainID), p.ContractAddress.Eq(c.address), p.PoolID.In(ids[c]...)).Find()
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			found[poolKey{contract: c, poolID: row.PoolID}] = row
+		}
+	}
+	res := make([]*model.PoolInfo, len(keys))
+	for i, k := range keys {
+		res[i] = found[k]
+	}
+	return res, nil
+}
+
+// positions 按 filters 分页查询持仓，返回值与 filters 一一对应
+func (r *resolver) positions(ctx context.Context, filters []positionFilter, pg page) ([]*connection[*model.UserPoolStat], error) {
+	var after int64
+	if pg.after != "" {
+		keys, err := decodeCursor("position", pg.after, 1)
+		if err != nil {
+			return nil, err
+		}
+		after = int64(keys[0])
+	}
+	s := r.q.UserPoolStat
+	queries := make([]*gorm.DB, len(filters))
+	for i, f := range filters {
+		do := s.WithContext(ctx).Where(s.ChainID.Eq(f.contract.chainID), s.ContractAddress.Eq(f.contract.address))
+		if f.user != "" {
+			do = do.Where(s.UserAddress.Eq(f.user))
+		}
+		if f.poolID != nil {
+			do = do.Where(s.PoolID.Eq(*f.poolID))
+		}
+		if f.hasStake != nil {
+			zero := types.NewBigIntFromInt64(0)
+			if *f.hasStake {
+				do = do.Where(s.StAmount.Gt(zero))
+			} else {
+				do = do.Where(genfield.Or(s.StAmount.IsNull(), s.StAmount.Eq(zero)))
+			}
+		}
+		if pg.after != "" {
+			do = do.Where(s.ID.Gt(after))
+		}
+		queries[i] = do.Order(s.ID).Limit(pg.first + 1).UnderlyingDB()
+	}
+	groups, err := findBatch(ctx, r.db, queries, func(a, b *model.UserPoolStat) bool { return a.ID < b.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]contractRef, len(filters))
+	for i, f := range filters {
+		contracts[i] = f.contract
+	}
+	if err := r.refreshPending(ctx, contracts, groups); err != nil {
+		return nil, err
+	}
+	res := make([]*connection[*model.UserPoolStat], len(groups))
+	for i, rows := range groups {
+		res[i] = newConnection(rows, pg, func(row *model.UserPoolStat) string { return encodeCursor("position", uint64(row.ID)) })
+	}
+	return res, nil
+}
+
+// userPositions 用户在合约各资金池中的全部持仓（数量不超过资金池数），返回值与 users 一一对应。同一合约的用户一次查询
+func (r *resolver) userPositions(ctx context.Context, users []*user) ([][]*model.UserPoolStat, error) {
+	var contracts []contractRef
+	addresses := make(map[contractRef][]string)
+	for _, u := range users {
+		if _, ok := addresses[u.contract]; !ok {
+			contracts = append(contracts, u.contract)
+		}
+		addresses[u.contract] = append(addresses[u.contract], u.address)
+	}
+
+	s := r.q.UserPoolStat
+	type userKey struct {
+		contract contractRef
+		address  string
+	}
+	found := make(map[userKey][]*model.UserPoolStat)
+	for _, c := range contracts {
+		rows, err := s.WithContext(ctx).Where(s.ChainID.Eq(c.chainID), s.ContractAddress.Eq(c.address), s.UserAddress.In(addresses[c]...)).Order(s.PoolID).Find()
+		if err != nil {
+			return nil, err
+		}
+		if err := reward.RefreshPending(ctx, r.db, c.chainID, c.address, rows); err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			k := userKey{contract: c, address: row.UserAddress}
+			found[k] = append(found[k], row)
+		}
+	}
+	res := make([][]*model.UserPoolStat, len(users))
+	for i, u := range users {
+		res[i] = found[userKey{contract: u.contract, address: u.address}]
+	}
+	return res, nil
+}
+
+// refreshPending 按合约计算各组持仓的待领取奖励，groups[i] 属于 contracts[i]
+func (r *resolver) refreshPending(ctx context.Context, contracts []contractRef, groups [][]*model.UserPoolStat) error {
+	var order []contractRef
+	byContract := make(map[contractRef][]*model.UserPoolStat)
+	for i, rows := range groups {
+		c := contracts[i]
+		if _, ok := byContract[c]; !ok {
+			order = append(order, c)
+		}
+		byContract[c] = append(byContract[c], rows...)
+	}
+	for _, c := range order {
+		if err := reward.RefreshPending(ctx, r.db, c.chainID, c.address, byContract[c]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unstakeRequests 按 filters 分页查询解质押请求，返回值与 filters 一一对应
+func (r *resolver) unstakeRequests(ctx context.Context, filters []unstakeRequestFilter, pg page) ([]*connection[*model.UserUnstakeRequest], error) {
+	var after int64
+	if pg.after != "" {
+		keys, err := decodeCursor("unstake", pg.after, 1)
+		if err != nil {
+			return nil, err
+		}
+		after = int64(keys[0])
+	}
+	u := r.q.UserUnstakeRequest
+	queries := make([]*gorm.DB, len(filters))
+	for i, f := range filters {
+		do := u.WithContext(ctx).Where(u.ChainID.Eq(f.contract.chainID), u.ContractAddress.Eq(f.contract.address))
+		if f.user != "" {
+			do = do.Where(u.UserAddress.Eq(f.user))
+		}
+		if f.poolID != nil {
+			do = do.Where(u.PoolID.Eq(*f.poolID))
+		}
+		if f.isWithdrawn != nil {
+			do = do.Where(u.IsWithdrawn.Is(*f.isWithdrawn))
+		}
+		if pg.after != "" {
+			do = do.Where(u.ID.Gt(after))
+		}
+		queries[i] = do.Order(u.ID).Limit(pg.first + 1).UnderlyingDB()
+	}
+	groups, err := findBatch(ctx, r.db, queries, func(a, b *model.UserUnstakeRequest) bool { return a.ID < b.ID })
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*connection[*model.UserUnstakeRequest], len(groups))
+	for i, rows := range groups {
+		res[i] = newConnection(rows, pg, func(row *model.UserUnstakeRequest) string { return encodeCursor("unstake", uint64(row.ID)) })
+	}
+	return res, nil
+}
+
+// events 按 filters 分页查询 (block_number, log_index) 排序的合约事件，返回值与 filters 一一对应。
+// 用户和资金池条件只匹配含对应索引参数的事件；同一层的条件来自同一个选择集，排序方向相同
+func (r *resolver) events(ctx context.Context, filters []eventFilter, pg page) ([]*connection[*model.ContractEvent], error) {
+	var cursor []uint64
+	if pg.after != "" {
+		keys, err := decodeCursor("event", pg.after, 2)
+		if err != nil {
+			return nil, err
+		}
+		cursor = keys
+	}
+	e := r.q.ContractEvent
+	queries := make([]*gorm.DB, len(filters))
+	for i, f := range filters {
+		do := e.WithContext(ctx).Where(e.ChainID.Eq(f.contract.chainID), e.ContractAddress.Eq(f.contract.address))
+		if len(f.names) > 0 {
+			do = do.Where(e.EventName.In(f.names...))
+		}
+		if f.fromBlock > 0 {
+			do = do.Where(e.BlockNumber.Gte(f.fromBlock))
+		}
+		if f.toBlock > 0 {
+			do = do.Where(e.BlockNumber.Lte(f.toBlock))
+		}
+		if f.user != "" || f.poolID != nil {
+			topics := map[int]genfield.String{1: e.Topic1, 2: e.Topic2}
+			var groups []genfield.Expr
+			for params, names := range stake.EventsWithParams(f.user != "", f.poolID != nil) {
+				conds := []genfield.Expr{e.EventName.In(names...)}
+				if f.user != "" {
+					conds = append(conds, topics[params.User].Eq(stake.AddressTopic(f.user)))
+				}
+				if f.poolID != nil {
+					conds = append(conds, topics[params.Pool].Eq(stake.PoolTopic(*f.poolID)))
+				}
+				groups = append(groups, genfield.And(conds...))
+			}
+			// 只有一组时 Or 不加括号，会和合约条件组合成 a AND b OR c
+			if len(groups) == 1 {
+				do = do.Where(groups[0])
+			} else {
+				do = do.Where(genfield.Or(groups...))
+			}
+		}
+		if cursor != nil {
+			// 按排序方向取游标 (blockNumber, logIndex) 之后的事件
+			blockNumber, logIndex := cursor[0], int32(cursor[1])
+			if f.desc {
+				do = do.Where(genfield.Or(e.BlockNumber.Lt(blockNumber), genfield.And(e.BlockNumber.Eq(blockNumber), e.LogIndex.Lt(logIndex))))
+			} else {
+				do = do.Where(genfield.Or(e.BlockNumber.Gt(blockNumber), genfield.And(e.BlockNumber.Eq(blockNumber), e.LogIndex.Gt(logIndex))))
+			}
+		}
+		if f.desc {
+			do = do.Order(e.BlockNumber.Desc(), e.LogIndex.Desc())
+		} else {
+			do = do.Order(e.BlockNumber, e.LogIndex)
+		}
+		queries[i] = do.Limit(pg.first + 1).UnderlyingDB()
+	}
+
+	desc := len(filters) > 0 && filters[0].desc
+	groups, err := findBatch(ctx, r.db, queries, func(a, b *model.ContractEvent) bool {
+		if a.BlockNumber != b.BlockNumber {
+			return (a.BlockNumber < b.BlockNumber) != desc
+		}
+		return (a.LogIndex < b.LogIndex) != desc
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*connection[*model.ContractEvent], len(groups))
+	for i, rows := range groups {
+		res[i] = newConnection(rows, pg, func(row *model.ContractEvent) string {
+			return encodeCursor("event", row.BlockNumber, uint64(row.LogIndex))
+		})
+	}
+	return res, nil
+}
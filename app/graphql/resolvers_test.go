@@ -0,0 +1,257 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/stake"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/query"
+)
+
+var (
+	testContract = ethCommon.HexToAddress("0x01c7a3d0e3ce1be6e6fd19dc0a3a4d2b6a9e0c01").Hex()
+	testAlice    = ethCommon.HexToAddress("0xa1").Hex()
+	testBob      = ethCommon.HexToAddress("0xb2").Hex()
+)
+
+// 金额列使用 TEXT，避免 sqlite 把大整数转为浮点数
+var testTables = []string{
+	`CREATE TABLE chain_contracts (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_name INT, contract_address TEXT,
+		created_tx_hash TEXT, abi TEXT, sync_mode TEXT, confirmations INT, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE sync_status (id INTEGER PRIMARY KEY AUTOINCREMENT, contract_address TEXT, chain_id INT, last_synced_block INT,
+		last_sync_time DATETIME, sync_error TEXT, is_syncing BOOL, created_at DATETIME, updated_at DATETIME)`,
+	`CREATE TABLE pool_info (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, pool_id INT, contract_address TEXT, st_token_address TEXT,
+		pool_weight TEXT, last_reward_block INT, acc_metanode_per_st TEXT DEFAULT '0', st_token_amount TEXT DEFAULT '0', min_deposit_amount TEXT,
+		unstake_locked_blocks INT, total_pool_weight TEXT, is_active BOOLEAN DEFAULT 1, created_block INT, created_tx TEXT,
+		created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE user_pool_stats (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, user_address TEXT, pool_id INT, contract_address TEXT,
+		st_amount TEXT DEFAULT '0', finished_metanode TEXT DEFAULT '0', pending_metanode TEXT DEFAULT '0', settled_metanode TEXT DEFAULT '0', total_deposited TEXT DEFAULT '0',
+		total_unstaked TEXT DEFAULT '0', total_withdrawn TEXT DEFAULT '0', total_claimed TEXT DEFAULT '0', last_deposit_block INT,
+		last_claim_block INT, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE user_unstake_requests (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, user_address TEXT, pool_id INT,
+		contract_address TEXT, amount TEXT, unlock_block INT, request_block INT, request_tx TEXT, is_withdrawn BOOLEAN DEFAULT 0,
+		withdrawn_block INT, withdrawn_tx TEXT, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE contract_events (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, event_name TEXT, topic0 TEXT,
+		topic1 TEXT, topic2 TEXT, topic3 TEXT, data TEXT, block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT,
+		created_at TIMESTAMP)`,
+}
+
+// newTestDB 建表并写入 pools 个资金池：alice 和 bob 在每个资金池各有一个持仓，alice 各有一个解质押请求，
+// 每个资金池有一个 Deposit 事件，另有一个不带资金池参数的 SetMetaNode 事件。queries 统计执行的 SQL 语句数，解析器会并发查询
+func newTestDB(t *testing.T, pools int) (db *gorm.DB, queries *atomic.Int32) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 每个连接是独立的内存数据库，解析器并发查询时也只使用同一个连接
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	for _, stmt := range testTables {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	exec := func(sql string, args ...interface{}) {
+		if err := db.Exec(sql, args...).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	exec(`INSERT INTO chain_contracts (chain_id, contract_name, contract_address, abi) VALUES (1, 1, ?, '[]')`, testContract)
+	for i := 0; i < pools; i++ {
+		exec(`INSERT INTO pool_info (chain_id, pool_id, contract_address, st_token_address, pool_weight, last_reward_block, min_deposit_amount, unstake_locked_blocks)
+			VALUES (1, ?, ?, ?, '100', 10, '1', 10)`, i, testContract, testAlice)
+		for _, u := range []string{testAlice, testBob} {
+			exec(`INSERT INTO user_pool_stats (chain_id, user_address, pool_id, contract_address, st_amount) VALUES (1, ?, ?, ?, '5')`, u, i, testContract)
+		}
+		exec(`INSERT INTO user_unstake_requests (chain_id, user_address, pool_id, contract_address, amount, unlock_block, request_block, request_tx)
+			VALUES (1, ?, ?, ?, '1', 20, 10, '0x01')`, testAlice, i, testContract)
+		exec(`INSERT INTO contract_events (chain_id, contract_address, event_name, topic0, topic1, topic2, block_number, block_timestamp, transaction_hash, log_index)
+			VALUES (1, ?, 'Deposit', '0x00', ?, ?, ?, 0, ?, 0)`, testContract, stake.AddressTopic(testAlice), stake.PoolTopic(int32(i)), 100+i, fmt.Sprintf("0x%02x", i))
+	}
+	exec(`INSERT INTO contract_events (chain_id, contract_address, event_name, topic0, block_number, block_timestamp, transaction_hash, log_index)
+		VALUES (1, ?, 'SetMetaNode', '0x00', 200, 0, '0xff', 0)`, testContract)
+
+	queries = new(atomic.Int32)
+	// 子查询以 DryRun 方式生成 SQL，不访问数据库
+	count := func(tx *gorm.DB) {
+		if !tx.DryRun {
+			queries.Add(1)
+		}
+	}
+	if err := db.Callback().Query().Before("gorm:query").Register("test:count", count); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Callback().Row().Before("gorm:row").Register("test:count", count); err != nil {
+		t.Fatal(err)
+	}
+	return db, queries
+}
+
+// TestNestedFieldsBatched 嵌套字段按层批量查询，查询次数与列表长度无关
+func TestNestedFieldsBatched(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		check func(t *testing.T, data string, pools int)
+	}{
+		{
+			name: "pool connections",
+			query: `{ pools(contract: "%s") { nodes { poolId
+				positions(first: 1) { nodes { user { address } pool { poolId } } pageInfo { hasNextPage } }
+				unstakeRequests { nodes { poolId pool { poolId } } }
+				events { nodes { name pool { poolId } } } } } }`,
+			check: func(t *testing.T, data string, pools int) {
+				var res struct {
+					Pools struct {
+						Nodes []struct {
+							PoolID    int32
+							Positions struct {
+								Nodes []struct {
+									User struct{ Address string }
+									Pool struct{ PoolID int32 }
+								}
+								PageInfo struct{ HasNextPage bool }
+							}
+							UnstakeRequests struct {
+								Nodes []struct {
+									PoolID int32
+									Pool   struct{ PoolID int32 }
+								}
+							}
+							Events struct {
+								Nodes []struct {
+									Name string
+									Pool struct{ PoolID int32 }
+								}
+							}
+						}
+					}
+				}
+				if err := json.Unmarshal([]byte(data), &res); err != nil {
+					t.Fatal(err)
+				}
+				if len(res.Pools.Nodes) != pools {
+					t.Fatalf("pools = %d, want %d", len(res.Pools.Nodes), pools)
+				}
+				for _, p := range res.Pools.Nodes {
+					pos, reqs, evs := p.Positions, p.UnstakeRequests.Nodes, p.Events.Nodes
+					// 每个资金池各自分页：first 1 只返回 alice 的持仓
+					if len(pos.Nodes) != 1 || pos.Nodes[0].User.Address != testAlice || pos.Nodes[0].Pool.PoolID != p.PoolID || !pos.PageInfo.HasNextPage {
+						t.Errorf("pool %d positions = %+v", p.PoolID, pos)
+					}
+					if len(reqs) != 1 || reqs[0].PoolID != p.PoolID || reqs[0].Pool.PoolID != p.PoolID {
+						t.Errorf("pool %d unstakeRequests = %+v", p.PoolID, reqs)
+					}
+					if len(evs) != 1 || evs[0].Name != "Deposit" || evs[0].Pool.PoolID != p.PoolID {
+						t.Errorf("pool %d events = %+v", p.PoolID, evs)
+					}
+				}
+			},
+		},
+		{
+			name:  "user connections",
+			query: `{ positions(contract: "%s") { nodes { poolId user { address positions { poolId } unstakeRequests { nodes { poolId } } events(orderDirection: desc) { nodes { blockNumber } } } } } }`,
+			check: func(t *testing.T, data string, pools int) {
+				var res struct {
+					Positions struct {
+						Nodes []struct {
+							User struct {
+								Address         string
+								Positions       []struct{ PoolID int32 }
+								UnstakeRequests struct{ Nodes []struct{ PoolID int32 } }
+								Events          struct{ Nodes []struct{ BlockNumber int } }
+							}
+						}
+					}
+				}
+				if err := json.Unmarshal([]byte(data), &res); err != nil {
+					t.Fatal(err)
+				}
+				if len(res.Positions.Nodes) != 2*pools {
+					t.Fatalf("positions = %d, want %d", len(res.Positions.Nodes), 2*pools)
+				}
+				for _, p := range res.Positions.Nodes {
+					u := p.User
+					wantReqs, wantEvents := 0, 0
+					if u.Address == testAlice {
+						wantReqs, wantEvents = pools, pools
+					}
+					if len(u.Positions) != pools || len(u.UnstakeRequests.Nodes) != wantReqs || len(u.Events.Nodes) != wantEvents {
+						t.Errorf("user %s = %+v", u.Address, u)
+					}
+					// 批量查询后每组仍按排序方向返回
+					if wantEvents > 1 && u.Events.Nodes[0].BlockNumber < u.Events.Nodes[1].BlockNumber {
+						t.Errorf("user %s events not in desc order: %+v", u.Address, u.Events.Nodes)
+					}
+				}
+			},
+		},
+		{
+			name:  "event pools",
+			query: `{ events(contract: "%s") { nodes { name pool { poolId } } } }`,
+			check: func(t *testing.T, data string, pools int) {
+				// 不带资金池参数的事件 pool 为 null
+				if want := `{"name":"SetMetaNode","pool":null}]}}`; !strings.HasSuffix(data, want) {
+					t.Errorf("data = %s, want suffix %s", data, want)
+				}
+				if strings.Count(data, `"pool":{"poolId"`) != pools {
+					t.Errorf("data = %s, want %d pools", data, pools)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts := make([]int32, 0, 2)
+			for _, pools := range []int{1, 3} {
+				db, queries := newTestDB(t, pools)
+				got, errs := execute(t, NewSchema(db), fmt.Sprintf(tt.query, testContract), nil)
+				if len(errs) > 0 {
+					t.Fatalf("%d pools: errors = %v", pools, errs[0])
+				}
+				tt.check(t, got, pools)
+				counts = append(counts, queries.Load())
+			}
+			if counts[0] != counts[1] {
+				t.Errorf("queries = %d for 1 pool, %d for 3 pools", counts[0], counts[1])
+			}
+		})
+	}
+}
+
+func TestFindBatch(t *testing.T) {
+	db, _ := newTestDB(t, 3)
+	r := &resolver{db: db, q: query.Use(db)}
+	contract := contractRef{chainID: 1, address: testContract}
+	pool := func(id int32) *int32 { return &id }
+
+	// 第二个条件没有匹配的行，第三个条件与第一个相同
+	conns, err := r.unstakeRequests(context.Background(), []unstakeRequestFilter{
+		{contract: contract, poolID: pool(1)},
+		{contract: contract, user: testBob},
+		{contract: contract, poolID: pool(1)},
+	}, page{first: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conns) != 3 || len(conns[0].edges) != 1 || len(conns[1].edges) != 0 || len(conns[2].edges) != 1 {
+		t.Fatalf("unstakeRequests() = %+v", conns)
+	}
+
+	// 空的条件列表不查询数据库
+	if conns, err := r.unstakeRequests(context.Background(), nil, page{first: 20}); err != nil || len(conns) != 0 {
+		t.Fatalf("unstakeRequests(nil) = %v, %v", conns, err)
+	}
+}
@@ -0,0 +1,216 @@
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/query"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/chaincontract"
+)
+
+// maxQueryDepth 选择集最大嵌套层数，内省查询同样受限，取值需容纳标准内省查询（IntrospectionQuery）的 ofType 嵌套
+const maxQueryDepth = 13
+
+// SDL 模式定义文档，字段由 query.go、objects.go 中的解析器实现
+//
+//go:embed schema.graphql
+var SDL string
+
+// errInternal 数据库错误不向客户端暴露细节
+var errInternal = errors.New("internal error")
+
+// NewSchema 质押数据的查询模式，类型定义见 schema.graphql。解析、校验和内省由 graphql-go 处理
+func NewSchema(db *gorm.DB) *graphqlgo.Schema {
+	r := &resolver{db: db, q: query.Use(db)}
+	return graphqlgo.MustParseSchema(SDL, &queryResolver{r: r},
+		graphqlgo.UseStringDescriptions(),
+		graphqlgo.MaxDepth(maxQueryDepth),
+	)
+}
+
+// internal 记录数据库错误并替换为 errInternal
+func internal(err error) error {
+	logx.Error(fmt.Sprintf("graphql: query error: %v", err))
+	return errInternal
+}
+
+// loadKey 嵌套字段按字段名和参数缓存整层的查询结果，同一字段以不同参数（别名）查询时分别批量查询
+func loadKey(field string, args interface{}) string {
+	b, _ := json.Marshal(args)
+	return field + ":" + string(b)
+}
+
+// contractArgs 顶层字段的合约参数
+type contractArgs struct {
+	Contract string
+	ChainID  *int32
+}
+
+type poolFilterInput struct {
+	IsActive       *bool
+	StTokenAddress *string
+}
+
+type positionFilterInput struct {
+	User     *string
+	PoolID   *int32
+	HasStake *bool
+}
+
+type unstakeRequestFilterInput struct {
+	User        *string
+	PoolID      *int32
+	IsWithdrawn *bool
+}
+
+type eventFilterInput struct {
+	NameIn         *[]string
+	User           *string
+	PoolID         *int32
+	BlockNumberGte *int32
+	BlockNumberLte *int32
+}
+
+type positionsArgs struct {
+	pageArgs
+	Where *positionFilterInput
+}
+
+type unstakeRequestsArgs struct {
+	pageArgs
+	Where *unstakeRequestFilterInput
+}
+
+type eventsArgs struct {
+	pageArgs
+	OrderDirection string
+	Where          *eventFilterInput
+}
+
+// contract 解析 contract 和 chainId 参数。未指定 chainId 时合约地址只能注册在一条链上，
+// 否则需要通过 chainId 指定
+func (r *resolver) contract(ctx context.Context, args contractArgs) (contractRef, error) {
+	address, err := addressArg("contract", &args.Contract)
+	if err != nil {
+		return contractRef{}, err
+	}
+	contracts, err := chaincontract.ListByAddress(ctx, r.db, address)
+	if err != nil {
+		return contractRef{}, internal(err)
+	}
+	var matches []contractRef
+	for _, c := range contracts {
+		if args.ChainID == nil || c.ChainID == *args.ChainID {
+			matches = append(matches, contractRef{chainID: c.ChainID, address: address})
+		}
+	}
+	switch {
+	case len(matches) == 1:
+		return matches[0], nil
+	case len(matches) > 1:
+		return contractRef{}, fmt.Errorf("contract %s is registered on multiple chains, argument %q is required", address, "chainId")
+	case args.ChainID != nil:
+		return contractRef{}, fmt.Errorf("contract %s is not registered on chain %d", address, *args.ChainID)
+	default:
+		return contractRef{}, fmt.Errorf("contract %s is not registered", address)
+	}
+}
+
+// addressArg 校验地址参数并转换为库中保存的校验和格式，未提供时返回空字符串
+func addressArg(name string, v *string) (string, error) {
+	if v == nil || *v == "" {
+		return "", nil
+	}
+	if !ethCommon.IsHexAddress(*v) {
+		return "", fmt.Errorf("argument %q is not a valid address: %s", name, *v)
+	}
+	return ethCommon.HexToAddress(*v).Hex(), nil
+}
+
+// nestedFieldError 嵌套列表的 where 中不能再指定由所属对象确定的字段
+func nestedFieldError(field string) error {
+	return fmt.Errorf("field %q in argument %q is determined by the parent object", field, "where")
+}
+
+func (w *poolFilterInput) filter() (f poolFilter, err error) {
+	if w == nil {
+		return f, nil
+	}
+	f.isActive = w.IsActive
+	f.stTokenAddress, err = addressArg("stTokenAddress", w.StTokenAddress)
+	return f, err
+}
+
+func (w *positionFilterInput) filter() (f positionFilter, err error) {
+	if w == nil {
+		return f, nil
+	}
+	f.poolID, f.hasStake = w.PoolID, w.HasStake
+	f.user, err = addressArg("user", w.User)
+	return f, err
+}
+
+func (w *unstakeRequestFilterInput) filter() (f unstakeRequestFilter, err error) {
+	if w == nil {
+		return f, nil
+	}
+	f.poolID, f.isWithdrawn = w.PoolID, w.IsWithdrawn
+	f.user, err = addressArg("user", w.User)
+	return f, err
+}
+
+func (a eventsArgs) filter() (f eventFilter, err error) {
+	f.desc = a.OrderDirection == "desc"
+	w := a.Where
+	if w == nil {
+		return f, nil
+	}
+	if w.NameIn != nil {
+		f.names = *w.NameIn
+	}
+	f.poolID = w.PoolID
+	if f.user, err = addressArg("user", w.User); err != nil {
+		return f, err
+	}
+	if f.fromBlock, err = blockArg("blockNumber_gte", w.BlockNumberGte); err != nil {
+		return f, err
+	}
+	f.toBlock, err = blockArg("blockNumber_lte", w.BlockNumberLte)
+	return f, err
+}
+
+// blockArg 区块号参数，未提供时返回 0（不限）
+func blockArg(name string, v *int32) (uint64, error) {
+	if v == nil {
+		return 0, nil
+	}
+	if *v < 0 {
+		return 0, fmt.Errorf("argument %q must not be negative", name)
+	}
+	return uint64(*v), nil
+}
+
+// poolContract 资金池所在的合约
+func poolContract(p *model.PoolInfo) contractRef {
+	return contractRef{chainID: p.ChainID, address: p.ContractAddress}
+}
+
+// eventTopic 返回 topic1/topic2 中指定位置的值，pos 为 0 表示事件不含该参数
+func eventTopic(e *model.ContractEvent, pos int) *string {
+	switch pos {
+	case 1:
+		return e.Topic1
+	case 2:
+		return e.Topic2
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+func execute(t *testing.T, schema *graphqlgo.Schema, query string, variables map[string]interface{}) (string, []*gqlerrors.QueryError) {
+	t.Helper()
+	res := schema.Exec(context.Background(), query, "", variables)
+	return string(res.Data), res.Errors
+}
+
+// introspectionQuery GraphiQL 等工具使用的标准内省查询
+const introspectionQuery = `
+query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives { name description locations args { ...InputValue } }
+  }
+}
+fragment FullType on __Type {
+  kind name description
+  fields(includeDeprecated: true) { name description args { ...InputValue } type { ...TypeRef } isDeprecated deprecationReason }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name description isDeprecated deprecationReason }
+  possibleTypes { ...TypeRef }
+}
+fragment InputValue on __InputValue { name description type { ...TypeRef } defaultValue }
+fragment TypeRef on __Type {
+  kind name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+func TestIntrospection(t *testing.T) {
+	db, _ := newTestDB(t, 1)
+	schema := NewSchema(db)
+
+	got, errs := execute(t, schema, introspectionQuery, nil)
+	if len(errs) > 0 {
+		t.Fatalf("IntrospectionQuery errors = %v", errs)
+	}
+	var res struct {
+		Schema struct {
+			QueryType struct{ Name string }
+			Types     []struct{ Name string }
+		} `json:"__schema"`
+	}
+	if err := json.Unmarshal([]byte(got), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Schema.QueryType.Name != "Query" {
+		t.Fatalf("queryType = %q", res.Schema.QueryType.Name)
+	}
+	names := make(map[string]bool)
+	for _, typ := range res.Schema.Types {
+		names[typ.Name] = true
+	}
+	for _, name := range []string{"Pool", "User", "Position", "UnstakeRequest", "Event", "EventConnection", "BigInt", "OrderDirection", "EventFilter"} {
+		if !names[name] {
+			t.Errorf("type %s missing from introspection", name)
+		}
+	}
+
+	// schema.graphql 中的描述字符串和参数默认值
+	got, errs = execute(t, schema, `{ scalar: __type(name: "BigInt") { description }
+		query: __type(name: "Query") { fields { name args { name defaultValue } } } }`, nil)
+	if len(errs) > 0 {
+		t.Fatalf("__type errors = %v", errs)
+	}
+	for _, want := range []string{`"description":"链上原始单位的十进制整数字符串"`, `{"name":"first","defaultValue":"20"}`, `{"name":"orderDirection","defaultValue":"asc"}`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("__type = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestQueryErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"unknown field", `{ pools(contract: "%s") { nodes { nope } } }`, `Cannot query field "nope"`},
+		{"mutation", `mutation { pools }`, "no mutations are offered"},
+		{"max depth", `{ pools(contract: "%s") { nodes { positions { nodes { user { events { nodes { pool { positions { nodes { pool { events { nodes { name } } } } } } } } } } } } } }`, "exceeds max depth"},
+		{"invalid address", `{ user(contract: "0x1", address: "0x01") { address } }`, `argument "contract" is not a valid address`},
+		{"unregistered contract", `{ pools(contract: "%s", chainId: 2) { nodes { poolId } } }`, "is not registered on chain 2"},
+		{"page size", `{ pools(contract: "%s", first: 101) { nodes { poolId } } }`, `argument "first" must be in [1, 100]`},
+		{"nested pool filter", `{ pools(contract: "%s") { nodes { positions(where: {poolId: 1}) { nodes { id } } } } }`, `field "poolId" in argument "where" is determined by the parent object`},
+		{"nested user filter", `{ user(contract: "%[1]s", address: "%[1]s") { events(where: {user: "%[1]s"}) { nodes { id } } } }`, `field "user" in argument "where" is determined by the parent object`},
+		{"negative block", `{ events(contract: "%s", where: {blockNumber_gte: -1}) { nodes { id } } }`, `argument "blockNumber_gte" must not be negative`},
+	}
+	db, _ := newTestDB(t, 1)
+	schema := NewSchema(db)
+	for _, tt := range tests {
+		query := tt.query
+		if strings.Contains(query, "%") {
+			query = fmt.Sprintf(query, testContract)
+		}
+		_, errs := execute(t, schema, query, nil)
+		if len(errs) != 1 || !strings.Contains(errs[0].Message, tt.want) {
+			t.Fatalf("%s: errors = %v, want %q", tt.name, errs, tt.want)
+		}
+	}
+}
+
+func TestQueryVariables(t *testing.T) {
+	db, _ := newTestDB(t, 2)
+	// 变量来自 JSON 请求体，数字为 float64
+	got, errs := execute(t, NewSchema(db), `query ($contract: String!, $pool: Int!, $first: Int) {
+		pool(contract: $contract, poolId: $pool) { poolId poolWeight positions(first: $first) { nodes { stAmount } pageInfo { hasNextPage } } } }`,
+		map[string]interface{}{"contract": testContract, "pool": float64(1), "first": float64(1)})
+	if len(errs) > 0 {
+		t.Fatalf("errors = %v", errs)
+	}
+	if want := `{"pool":{"poolId":1,"poolWeight":"100","positions":{"nodes":[{"stAmount":"5"}],"pageInfo":{"hasNextPage":true}}}}`; got != want {
+		t.Fatalf("data = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// superviseRestartDelay 任务意外退出后的重启等待时间
+const superviseRestartDelay = 5 * time.Second
+
+// Supervise 在后台运行 fn，fn 发生 panic 或在 ctx 结束前返回时自动重启，ctx 结束后退出
+func Supervise(ctx context.Context, name string, fn func()) {
+	threading.GoSafe(func() {
+		for {
+			threading.RunSafe(fn)
+			if ctx.Err() != nil {
+				return
+			}
+			logx.Error(fmt.Sprintf("task %s exited unexpectedly, restarting in %s", name, superviseRestartDelay))
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(superviseRestartDelay):
+			}
+		}
+	})
+}
@@ -4,24 +4,38 @@ import (
 	"context"
 
 	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
-	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/outbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/rpcpool"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/webhook"
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
 )
 
 type ServiceContext struct {
-	Context         context.Context
-	Config          *config.Config
-	DB              *gorm.DB
-	RedisClient     *redis.Client
-	ContractInfoMap map[int32]*ContractInfo
+	Context     context.Context
+	Config      *config.Config
+	DB          *gorm.DB
+	RedisClient *redis.Client
+	Sink        outbox.Sink     // 事件发件箱的消息总线，未启用发件箱时为 nil
+	Webhooks    *webhook.Sender // Webhook 投递客户端，未启用 webhook 时为 nil
+	Contracts   []*ContractInfo // chain_contracts 中的所有合约，每个合约对应一个同步任务
 }
 
 type ContractInfo struct {
-	ChainID      int32
-	ContractName string
-	ABIStr       string
-	Address      string
-	CreatedHash  *string
-	Client       *ethclient.Client
+	ChainID       int32
+	ContractName  int32 // 合约类型标识符，对应 indexer 中注册的处理模块
+	ABIStr        string
+	Address       string
+	CreatedHash   *string
+	SyncMode      string        // 同步高度模式，见 SyncModeLatest 等常量
+	Confirmations uint64        // SyncModeConfirmations 模式下的确认数
+	Client        *rpcpool.Pool // 合约所在链的RPC端点池，同一条链上的合约共用
 }
+
+// 同步高度模式：决定 queryLogs 同步到哪个区块高度
+const (
+	SyncModeLatest        = "latest"        // 同步到最新区块（未确认）
+	SyncModeConfirmations = "confirmations" // 落后最新区块 Confirmations 个区块
+	SyncModeSafe          = "safe"          // 同步到 safe 标签区块
+	SyncModeFinalized     = "finalized"     // 同步到 finalized 标签区块，不会发生重组
+)
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -11,6 +13,11 @@ type Config struct {
 	Monitor         *MonitorConfig `toml:"monitor" mapstructure:"monitor" json:"monitor"`
 	Log             logx.LogConf   `toml:"log" mapstructure:"log" json:"log"`
 	Redis           *RedisConfig   `toml:"redis" mapstructure:"redis" json:"redis"`
+	Sync            *SyncConfig    `toml:"sync" mapstructure:"sync" json:"sync"`
+	RPC             *RPCConfig     `toml:"rpc" mapstructure:"rpc" json:"rpc"`
+	API             *APIConfig     `toml:"api" mapstructure:"api" json:"api"`
+	Outbox          *OutboxConfig  `toml:"outbox" mapstructure:"outbox" json:"outbox"`
+	Webhook         *WebhookConfig `toml:"webhook" mapstructure:"webhook" json:"webhook"`
 	ChainID         int64          `toml:"chainId" mapstructure:"chainId" json:"chainId"`
 	RPCURL          string         `toml:"rpcUrl" mapstructure:"rpcUrl" json:"rpcUrl"`
 	ContractABI     string         `toml:"contractAbi" mapstructure:"contractAbi" json:"contractAbi"`
@@ -36,6 +43,47 @@ type RedisConfig struct {
 	DB       int    `toml:"db" mapstructure:"db" json:"db"`
 }
 
+// SyncConfig 区块同步配置
+type SyncConfig struct {
+	MaxBlockRange uint64        `toml:"max_block_range" mapstructure:"max_block_range" json:"max_block_range"` // eth_getLogs 单次查询的最大区块数
+	PollInterval  time.Duration `toml:"poll_interval" mapstructure:"poll_interval" json:"poll_interval"`       // 追上目标高度后的轮询间隔
+}
+
+// RPCConfig RPC端点健康检查配置
+type RPCConfig struct {
+	HealthCheckInterval time.Duration `toml:"health_check_interval" mapstructure:"health_check_interval" json:"health_check_interval"` // 端点健康检查间隔
+	MaxHeadLag          uint64        `toml:"max_head_lag" mapstructure:"max_head_lag" json:"max_head_lag"`                            // 端点最新区块允许落后的区块数，超过后不再选用
+}
+
+// APIConfig 只读查询 API 配置
+type APIConfig struct {
+	Host               string        `toml:"host" mapstructure:"host" json:"host"`
+	Port               int           `toml:"port" mapstructure:"port" json:"port"`
+	Timeout            time.Duration `toml:"timeout" mapstructure:"timeout" json:"timeout"`                                        // 单个请求的超时时间
+	MaxPageSize        int           `toml:"max_page_size" mapstructure:"max_page_size" json:"max_page_size"`                      // 分页查询每页的最大条数
+	StreamPollInterval time.Duration `toml:"stream_poll_interval" mapstructure:"stream_poll_interval" json:"stream_poll_interval"` // 事件推送未收到同步通知时的兜底查询和心跳间隔
+}
+
+// OutboxConfig 事件发件箱配置，启用后每条事件在同步事务中写入 event_outbox，由后台 relay 发布到消息总线
+type OutboxConfig struct {
+	Enabled      bool          `toml:"enabled" mapstructure:"enabled" json:"enabled"`
+	Sink         string        `toml:"sink" mapstructure:"sink" json:"sink"`                            // 消息总线类型，目前支持 redis
+	StreamPrefix string        `toml:"stream_prefix" mapstructure:"stream_prefix" json:"stream_prefix"` // Redis Stream 键前缀，完整键为 <prefix>:<chainId>:<合约地址>
+	MaxLen       int64         `toml:"max_len" mapstructure:"max_len" json:"max_len"`                   // 每个 Stream 保留的大致消息数，0 表示不裁剪
+	BatchSize    int           `toml:"batch_size" mapstructure:"batch_size" json:"batch_size"`          // 每轮发布的最大消息数
+	PollInterval time.Duration `toml:"poll_interval" mapstructure:"poll_interval" json:"poll_interval"` // 没有新事件通知时的轮询间隔
+	Retention    time.Duration `toml:"retention" mapstructure:"retention" json:"retention"`             // 已发布消息在 event_outbox 中的保留时间
+}
+
+// WebhookConfig Webhook 投递配置，启用后匹配 webhook_subscriptions 的事件在同步事务中写入 webhook_deliveries，由后台按订阅投递
+type WebhookConfig struct {
+	Enabled      bool          `toml:"enabled" mapstructure:"enabled" json:"enabled"`
+	Timeout      time.Duration `toml:"timeout" mapstructure:"timeout" json:"timeout"`                   // 单次投递的 HTTP 超时
+	PollInterval time.Duration `toml:"poll_interval" mapstructure:"poll_interval" json:"poll_interval"` // 没有新事件通知时检查待投递记录的间隔
+	MaxAttempts  int32         `toml:"max_attempts" mapstructure:"max_attempts" json:"max_attempts"`    // 最大投递次数，超过后标记为 failed
+	Workers      int           `toml:"workers" mapstructure:"workers" json:"workers"`                   // 每个合约并发投递的订阅数
+}
+
 func UnmarshalCmdConfig() (*Config, error) {
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, err
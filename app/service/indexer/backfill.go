@@ -0,0 +1,284 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// backfillChunk 回填的一个区块区间及其拉取结果
+type backfillChunk struct {
+	from, to uint64
+	logs     []ethereumTypes.Log
+	headers  []*ethereumTypes.Header
+	err      error
+	done     chan struct{}
+}
+
+// Backfill 回填 [from, to] 区间的历史事件：按 chunkSize 切分区间，由 workers 个协程并发拉取日志和区块头，
+// 再严格按 (block, logIndex) 顺序逐个区间写库（GetNextPoolID 等状态依赖事件顺序）。
+// 已处理过的日志按 (tx, logIndex) 跳过，因此回填已同步的区间不会修改任何数据，重新处理已同步的区块使用 Reindex；
+// 区间与同步进度相接时一并推进 sync_status。
+// from 不能晚于同步进度的下一个区块，否则跳过的区块的事件永远不会被处理。
+// 回填期间持有合约同步租约，同一合约的 daemon 正在运行时返回错误。
+// to 为 0 时回填到当前可同步的最高区块
+func (t *Task) Backfill(ctx context.Context, from, to, chunkSize uint64, workers int) error {
+	return t.WithLease(ctx, "backfill", func(ctx context.Context) error {
+		return t.backfill(ctx, from, to, chunkSize, workers)
+	})
+}
+
+// Reindex 重新处理从 from 到同步进度的已同步区块（如修复 handler 后重建派生数据），整个过程持有合约同步租约。
+// 派生表依赖之前所有事件的累计结果，只能从 from 一直重建到同步进度：from 在同步进度之前 MaxReorgDepth 个区块以内时
+// 按链重组的方式用快照回滚 from 之后写入的事件和派生表；更早的区块没有快照，此时清空该合约的派生表、事件和区块哈希，
+// 把同步进度重置到部署区块，从第一个区块全量重建
+func (t *Task) Reindex(ctx context.Context, from, chunkSize uint64, workers int) error {
+	return t.WithLease(ctx, "backfill", func(ctx context.Context) error {
+		lastHeight, err := t.getCheckpoint(ctx)
+		if err != nil {
+			return fmt.Errorf("Reindex: %w", err)
+		}
+		ancestor, full, err := reindexAncestor(from, lastHeight)
+		if err != nil {
+			return fmt.Errorf("Reindex: %w", err)
+		}
+
+		if full {
+			if from, err = t.firstBlock(ctx); err != nil {
+				return fmt.Errorf("Reindex: %w", err)
+			}
+			ancestor = from - 1
+			logx.Info(fmt.Sprintf("reindex %s from scratch, reset to %d, rebuild to %d", t.Name(), ancestor, lastHeight))
+			err = t.resetTo(ctx, ancestor)
+		} else {
+			logx.Info(fmt.Sprintf("reindex %s, roll back to %d, rebuild to %d", t.Name(), ancestor, lastHeight))
+			err = t.rollbackTo(ctx, ancestor)
+		}
+		if err != nil {
+			return fmt.Errorf("Reindex: %w", err)
+		}
+		t.cacheCheckpoint(ctx, ancestor)
+		t.notifyEvents(ctx, ancestor, true)
+		return t.backfill(ctx, from, lastHeight, chunkSize, workers)
+	})
+}
+
+// reindexAncestor 重新处理从 from 开始的区块时需要回滚到的区块，from 超出已同步范围时返回错误；
+// from 早于重组快照的保留窗口时返回 full，需要清空数据从第一个区块全量重建
+func reindexAncestor(from, lastHeight uint64) (ancestor uint64, full bool, err error) {
+	if lastHeight == 0 {
+		return 0, false, fmt.Errorf("nothing synced yet, use backfill without reindex")
+	}
+	if from == 0 || from > lastHeight {
+		return 0, false, fmt.Errorf("from %d is outside the synced blocks [1, %d]", from, lastHeight)
+	}
+	if lastHeight > MaxReorgDepth && from <= lastHeight-MaxReorgDepth {
+		return 0, true, nil
+	}
+	return from - 1, false, nil
+}
+
+func (t *Task) backfill(ctx context.Context, from, to, chunkSize uint64, workers int) error {
+	if chunkSize == 0 {
+		chunkSize = t.maxBlockRange()
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+
+	currentHeight, err := t.getSyncHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("Backfill: get sync height error: %w", err)
+	}
+	if to == 0 {
+		to = currentHeight
+	}
+	if from > to {
+		return fmt.Errorf("Backfill: invalid range [%d, %d]", from, to)
+	}
+	if to > currentHeight {
+		return fmt.Errorf("Backfill: to %d is beyond sync height %d", to, currentHeight)
+	}
+	next, err := t.nextBlock(ctx)
+	if err != nil {
+		return fmt.Errorf("Backfill: %w", err)
+	}
+	if from > next {
+		return fmt.Errorf("Backfill: from %d leaves a gap after the sync checkpoint, start from block %d or earlier", from, next)
+	}
+
+	var chunks []*backfillChunk
+	for start := from; ; start += chunkSize {
+		end := min(start+chunkSize-1, to)
+		chunks = append(chunks, &backfillChunk{from: start, to: end, done: make(chan struct{})})
+		if end == to {
+			break
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	// window 限制已拉取但尚未写库的区间数量，避免拉取速度远超写库时占用过多内存
+	window := make(chan struct{}, workers*2)
+	jobs := make(chan *backfillChunk)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				c.logs, c.headers, c.err = t.fetchChunk(ctx, c.from, c.to)
+				close(c.done)
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for _, c := range chunks {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- c:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	// 提前返回时先取消拉取，再等待所有 worker 退出
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	for _, c := range chunks {
+		select {
+		case <-c.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if c.err != nil {
+			return fmt.Errorf("Backfill: fetch [%d, %d] error: %w", c.from, c.to, c.err)
+		}
+		if err := t.applyBackfillChunk(ctx, c, currentHeight, next); err != nil {
+			return fmt.Errorf("Backfill: apply [%d, %d] error: %w", c.from, c.to, err)
+		}
+		logx.Info(fmt.Sprintf("backfill %s, start: %d, end: %d, logs: %d, target: %d", t.Name(), c.from, c.to, len(c.logs), to))
+		if len(c.logs) > 0 {
+			t.notifyEvents(ctx, c.to, false)
+		}
+		// 释放已写库区间占用的内存
+		c.logs, c.headers = nil, nil
+		<-window
+	}
+	return nil
+}
+
+// fetchChunk 拉取区间内的日志和区块头
+func (t *Task) fetchChunk(ctx context.Context, from, to uint64) ([]ethereumTypes.Log, []*ethereumTypes.Header, error) {
+	logs, err := t.fetchChunkLogs(ctx, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 按 (block, logIndex) 排序，保证写库顺序与链上顺序一致
+	slices.SortFunc(logs, func(a, b ethereumTypes.Log) int {
+		if a.BlockNumber != b.BlockNumber {
+			if a.BlockNumber < b.BlockNumber {
+				return -1
+			}
+			return 1
+		}
+		return int(a.Index) - int(b.Index)
+	})
+
+	fetchCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+	headers, err := t.fetchHeaders(fetchCtx, headerBlockNumbers(logs, to))
+	if err != nil {
+		return nil, nil, err
+	}
+	return logs, headers, nil
+}
+
+// fetchChunkLogs 拉取区间内的日志，节点报错区间过大时二分后分别拉取，被限流时按指数退避后重试
+func (t *Task) fetchChunkLogs(ctx context.Context, from, to uint64) ([]ethereumTypes.Log, error) {
+	for attempts := int32(1); ; attempts++ {
+		logs, err := t.filterChunkLogs(ctx, from, to)
+		switch {
+		case err == nil:
+			return logs, nil
+		case isRangeTooLargeError(err) && from < to:
+			mid := from + (to-from)/2
+			left, err := t.fetchChunkLogs(ctx, from, mid)
+			if err != nil {
+				return nil, err
+			}
+			right, err := t.fetchChunkLogs(ctx, mid+1, to)
+			if err != nil {
+				return nil, err
+			}
+			return append(left, right...), nil
+		case !isRateLimitError(err):
+			return nil, err
+		}
+		backoff := retryBackoff(rateLimitBaseBackoff, rateLimitMaxBackoff, attempts)
+		logx.Info(fmt.Sprintf("backfill %s [%d, %d]: rate limited, back off %s, err: %v", t.Name(), from, to, backoff, err))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+func (t *Task) filterChunkLogs(ctx context.Context, from, to uint64) ([]ethereumTypes.Log, error) {
+	fetchCtx, cancel := context.WithTimeout(ctx, 60*time.Second)
+	defer cancel()
+	return t.Client.FilterLogs(fetchCtx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(from),
+		ToBlock:   new(big.Int).SetUint64(to),
+		Addresses: []ethCommon.Address{ethCommon.HexToAddress(t.Address)},
+	})
+}
+
+// nextBlock 同步进度的下一个区块，尚未同步过时为首次同步的起始区块
+func (t *Task) nextBlock(ctx context.Context) (uint64, error) {
+	lastHeight, err := t.getCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if lastHeight == 0 {
+		return t.firstBlock(ctx)
+	}
+	return lastHeight + 1, nil
+}
+
+// applyBackfillChunk 在同一事务中写入一个回填区间；区间与当前同步进度相接时推进同步进度。
+// firstBlock 为回填开始时的 nextBlock，用于尚未同步过时判断区间是否相接
+func (t *Task) applyBackfillChunk(ctx context.Context, c *backfillChunk, currentHeight, firstBlock uint64) error {
+	return t.applyRange(ctx, c.logs, c.headers, currentHeight, func(ctx context.Context) error {
+		lastHeight, err := t.getCheckpoint(ctx)
+		if err != nil {
+			return err
+		}
+		next := lastHeight + 1
+		if lastHeight == 0 {
+			next = firstBlock
+		}
+		if next >= c.from && next <= c.to {
+			return t.saveCheckpoint(ctx, c.to, c.to < currentHeight)
+		}
+		return nil
+	})
+}
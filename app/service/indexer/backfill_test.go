@@ -0,0 +1,32 @@
+package indexer
+
+import "testing"
+
+func TestReindexAncestor(t *testing.T) {
+	tests := []struct {
+		name         string
+		from         uint64
+		lastHeight   uint64
+		wantAncestor uint64
+		wantFull     bool
+		wantErr      bool
+	}{
+		{"nothing synced", 1, 0, 0, false, true},
+		{"from zero", 0, 100, 0, false, true},
+		{"beyond checkpoint", 101, 100, 0, false, true},
+		{"last synced block", 100, 100, 99, false, false},
+		{"short history", 1, 100, 0, false, false},
+		{"oldest block in window", 1000 - MaxReorgDepth + 1, 1000, 1000 - MaxReorgDepth, false, false},
+		// 快照窗口之前的区块清空数据后全量重建
+		{"older than window", 1000 - MaxReorgDepth, 1000, 0, true, false},
+	}
+	for _, tt := range tests {
+		ancestor, full, err := reindexAncestor(tt.from, tt.lastHeight)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("%s: reindexAncestor(%d, %d) error = %v, wantErr %v", tt.name, tt.from, tt.lastHeight, err, tt.wantErr)
+		}
+		if err == nil && (ancestor != tt.wantAncestor || full != tt.wantFull) {
+			t.Fatalf("%s: reindexAncestor(%d, %d) = %d, %v, want %d, %v", tt.name, tt.from, tt.lastHeight, ancestor, full, tt.wantAncestor, tt.wantFull)
+		}
+	}
+}
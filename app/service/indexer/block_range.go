@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultMaxBlockRange = 2000            // 未配置 sync.max_block_range 时的最大查询区间
+	initialBlockRange    = 10              // 启动时的查询区间
+	defaultPollInterval  = 1 * time.Second // 未配置 sync.poll_interval 时的轮询间隔
+	growLogsThreshold    = 1000            // 单次查询结果少于该数量时扩大区间
+	rateLimitBaseBackoff = 2 * time.Second // 被节点限流后首次等待时间，之后按指数增长
+	rateLimitMaxBackoff  = time.Minute
+)
+
+// rangeTooLargeErrors 各节点服务商在查询区间过大或结果过多时返回的错误信息。
+// 只匹配明确指向区间或结果数量的信息，"rate limit exceeded" 等限流错误不在此列
+var rangeTooLargeErrors = []string{
+	"query returned more than",            // Infura、Geth: query returned more than 10000 results
+	"log response size exceeded",          // Alchemy
+	"response size exceeded",              // Alchemy 等
+	"block range too large",               // Cloudflare 等
+	"block range is too wide",             // Ankr
+	"block range limit exceeded",          // Pokt
+	"exceed maximum block range",          // BSC、Erigon: exceed maximum block range: 5000
+	"exceeds max block range",             // Erigon
+	"requested range exceeds maximum",     // Besu
+	"eth_getlogs is limited to",           // QuickNode: eth_getLogs is limited to a 10,000 range
+	"query exceeds max results",           // Chainstack
+	"too many results",                    // 通用
+	"logs matched by query exceeds limit", // Nethermind
+}
+
+// rateLimitErrors 节点限流时返回的错误信息
+var rateLimitErrors = []string{
+	"rate limit",
+	"too many requests",
+	"compute units per second", // Alchemy
+	"request count exceeded",   // Infura: daily request count exceeded
+	"capacity exceeded",
+}
+
+// jsonrpcLimitExceeded EIP-1474 的 "limit exceeded" 错误码，部分服务商同时用于区间过大和限流
+const jsonrpcLimitExceeded = -32005
+
+// isRangeTooLargeError 判断 eth_getLogs 错误是否由查询区间过大引起
+func isRangeTooLargeError(err error) bool {
+	return containsAny(err, rangeTooLargeErrors)
+}
+
+// isRateLimitError 判断错误是否由节点限流引起：HTTP 429、限流错误信息，或未说明原因的 -32005 错误
+func isRateLimitError(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if containsAny(err, rateLimitErrors) {
+		return true
+	}
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.ErrorCode() == jsonrpcLimitExceeded && !isRangeTooLargeError(err)
+}
+
+func containsAny(err error, patterns []string) bool {
+	msg := strings.ToLower(err.Error())
+	for _, s := range patterns {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Task) maxBlockRange() uint64 {
+	if t.Config != nil && t.Config.Sync != nil && t.Config.Sync.MaxBlockRange > 0 {
+		return t.Config.Sync.MaxBlockRange
+	}
+	return defaultMaxBlockRange
+}
+
+func (t *Task) pollInterval() time.Duration {
+	if t.Config != nil && t.Config.Sync != nil && t.Config.Sync.PollInterval > 0 {
+		return t.Config.Sync.PollInterval
+	}
+	return defaultPollInterval
+}
+
+// currentBlockRange 返回本轮查询区间大小
+func (t *Task) currentBlockRange() uint64 {
+	if t.blockRange == 0 {
+		t.blockRange = min(initialBlockRange, t.maxBlockRange())
+	}
+	return t.blockRange
+}
+
+// growBlockRange 查询结果较少时翻倍扩大区间，不超过配置的最大值
+func (t *Task) growBlockRange(logCount int) {
+	if logCount >= growLogsThreshold {
+		return
+	}
+	t.blockRange = min(t.currentBlockRange()*2, t.maxBlockRange())
+}
+
+// shrinkBlockRange 节点报错区间过大时减半，最小为 1
+func (t *Task) shrinkBlockRange() {
+	t.blockRange = max(t.currentBlockRange()/2, 1)
+}
+
+// backoffRateLimit 被节点限流后按指数退避暂停同步
+func (t *Task) backoffRateLimit() time.Duration {
+	t.rateLimitAttempts++
+	backoff := retryBackoff(rateLimitBaseBackoff, rateLimitMaxBackoff, t.rateLimitAttempts)
+	t.pausedUntil = time.Now().Add(backoff)
+	return backoff
+}
+
+// resetRateLimit 请求成功后清除限流退避
+func (t *Task) resetRateLimit() {
+	t.rateLimitAttempts = 0
+	t.pausedUntil = time.Time{}
+}
@@ -0,0 +1,78 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// jsonError 模拟节点返回的 JSON-RPC 错误
+type jsonError struct {
+	code int
+	msg  string
+}
+
+func (e jsonError) Error() string  { return e.msg }
+func (e jsonError) ErrorCode() int { return e.code }
+
+func TestIsRangeTooLargeError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("query returned more than 10000 results"), true},
+		{errors.New("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), true},
+		{errors.New("exceed maximum block range: 5000"), true},
+		{errors.New("block range is too wide"), true},
+		{fmt.Errorf("rpc chain 1 eth_getLogs: %w", jsonError{-32005, "query returned more than 10000 results"}), true},
+		{errors.New("rate limit exceeded"), false},
+		{jsonError{-32005, "limit exceeded"}, false},
+		{errors.New("connection refused"), false},
+	}
+	for _, tt := range tests {
+		if got := isRangeTooLargeError(tt.err); got != tt.want {
+			t.Errorf("isRangeTooLargeError(%q) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{errors.New("rate limit exceeded"), true},
+		{errors.New("429 Too Many Requests"), true},
+		{fmt.Errorf("https://node: %w", rpc.HTTPError{StatusCode: 429, Status: "429"}), true},
+		{jsonError{-32005, "limit exceeded"}, true},
+		{errors.New("Your app has exceeded its compute units per second capacity"), true},
+		{jsonError{-32005, "query returned more than 10000 results"}, false},
+		{rpc.HTTPError{StatusCode: 502, Status: "502 Bad Gateway"}, false},
+		{errors.New("execution reverted"), false},
+	}
+	for _, tt := range tests {
+		if got := isRateLimitError(tt.err); got != tt.want {
+			t.Errorf("isRateLimitError(%q) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     string
+	}{
+		{1, "2s"},
+		{2, "4s"},
+		{5, "32s"},
+		{6, "1m0s"},
+		{30, "1m0s"},
+	}
+	for _, tt := range tests {
+		if got := retryBackoff(rateLimitBaseBackoff, rateLimitMaxBackoff, tt.attempts).String(); got != tt.want {
+			t.Errorf("retryBackoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/common"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncstatus"
+)
+
+// getCheckpoint 读取最后同步的区块号，以 sync_status 表为准。
+// 表中没有记录时兼容读取旧版本写在 Redis 中的进度，都没有则返回 0
+func (t *Task) getCheckpoint(ctx context.Context) (uint64, error) {
+	status, err := syncstatus.GetByContractAndChain(ctx, t.DB, t.Address, t.ChainID)
+	if err == nil {
+		return status.LastSyncedBlock, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, fmt.Errorf("getCheckpoint: get sync_status error: %w", err)
+	}
+
+	lastHeight, err := t.RedisClient.Get(ctx, common.GetKey(t.ChainID, t.Address)).Uint64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		logx.Info(err)
+	}
+	return lastHeight, nil
+}
+
+// saveCheckpoint 在当前事务（t.DB）中写入同步进度，并把已重新处理的死信标记为已解决
+func (t *Task) saveCheckpoint(ctx context.Context, lastSyncedBlock uint64, isSyncing bool) error {
+	if err := syncstatus.SaveCheckpoint(ctx, t.DB, t.Address, t.ChainID, lastSyncedBlock, isSyncing); err != nil {
+		return fmt.Errorf("saveCheckpoint: save sync_status error: %w", err)
+	}
+	return t.resolveFailedEvents(ctx, lastSyncedBlock)
+}
+
+// cacheCheckpoint 事务提交后把同步进度写入 Redis，仅作为缓存供外部读取
+func (t *Task) cacheCheckpoint(ctx context.Context, lastSyncedBlock uint64) {
+	if err := t.RedisClient.Set(ctx, common.GetKey(t.ChainID, t.Address), lastSyncedBlock, 0).Err(); err != nil {
+		logx.Info(err)
+	}
+}
+
+// recordSyncError 记录同步错误到 sync_status，同步进度保持不变
+func (t *Task) recordSyncError(syncErr error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := syncstatus.UpdateSyncError(ctx, t.DB, t.Address, t.ChainID, syncErr.Error()); err != nil {
+		logx.Error("recordSyncError: update sync_status error: ", err)
+	}
+}
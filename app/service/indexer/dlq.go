@@ -0,0 +1,141 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/failedevents"
+)
+
+const (
+	handleMaxAttempts  = 5               // 同一日志连续处理失败该次数后写入死信队列并暂停同步
+	handleBaseBackoff  = 2 * time.Second // 处理失败后首次重试区间的等待时间，之后按指数增长
+	handleMaxBackoff   = time.Minute
+	haltedPollInterval = 10 * time.Second // 同步暂停期间检查死信是否已处理的间隔
+)
+
+// retryBackoff 第 attempts 次失败后的指数退避等待时间，从 base 开始每次翻倍，不超过 max
+func retryBackoff(base, max time.Duration, attempts int32) time.Duration {
+	backoff := base
+	for i := int32(1); i < attempts && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		backoff = max
+	}
+	return backoff
+}
+
+// handlerError 事件 handler 处理失败，整个区间回滚后重试
+type handlerError struct {
+	Log         ethereumTypes.Log
+	HandlerName string
+	Err         error
+}
+
+func (e *handlerError) Error() string {
+	return fmt.Sprintf("handle %s, Block: %d, TxHash: %s, LogIndex: %d: %v", e.HandlerName, e.Log.BlockNumber, e.Log.TxHash.Hex(), e.Log.Index, e.Err)
+}
+
+func (e *handlerError) Unwrap() error { return e.Err }
+
+// logKey 日志的唯一标识
+func logKey(l ethereumTypes.Log) string {
+	return fmt.Sprintf("%s:%d", l.TxHash.Hex(), l.Index)
+}
+
+// onHandlerError 区间因 handler 失败回滚后调用：同一日志连续失败时按指数退避重试区间，
+// 达到 handleMaxAttempts 次后写入死信队列。待处理的死信会暂停同步，同步进度停在该日志之前，
+// 后续事件不会在缺失状态上继续处理，由运维通过 dlq retry / dlq drop 恢复
+func (t *Task) onHandlerError(ctx context.Context, he *handlerError) {
+	key := logKey(he.Log)
+	if t.failingLog != key {
+		t.failingLog, t.failingAttempts = key, 0
+	}
+	t.failingAttempts++
+	if t.failingAttempts < handleMaxAttempts {
+		backoff := retryBackoff(handleBaseBackoff, handleMaxBackoff, t.failingAttempts)
+		t.pausedUntil = time.Now().Add(backoff)
+		logx.Error(fmt.Sprintf("%s: %v, attempt %d, range will be retried in %s", t.Name(), he, t.failingAttempts, backoff))
+		return
+	}
+
+	if err := t.deadLetter(ctx, he); err != nil {
+		// 写入失败时按最大间隔继续重试区间
+		t.pausedUntil = time.Now().Add(handleMaxBackoff)
+		logx.Error("onHandlerError: ", err)
+		return
+	}
+	t.failingLog, t.failingAttempts = "", 0
+	logx.Error(fmt.Sprintf("%s: %v, dead-lettered after %d attempts, sync halted until the event is retried or dropped", t.Name(), he, handleMaxAttempts))
+}
+
+// deadLetter 把连续处理失败的日志写入死信队列，状态为 pending 时同步任务暂停
+func (t *Task) deadLetter(ctx context.Context, he *handlerError) error {
+	rawLog, err := json.Marshal(he.Log)
+	if err != nil {
+		return fmt.Errorf("deadLetter: marshal log error: %w", err)
+	}
+	if err := failedevents.Create(ctx, t.DB, &model.FailedEvent{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		EventName:       t.eventName(he.Log.Topics[0].Hex()),
+		HandlerName:     he.HandlerName,
+		BlockNumber:     he.Log.BlockNumber,
+		TransactionHash: he.Log.TxHash.Hex(),
+		LogIndex:        int32(he.Log.Index),
+		RawLog:          string(rawLog),
+		ErrorMessage:    he.Err.Error(),
+		Attempts:        handleMaxAttempts,
+		Status:          failedevents.StatusPending,
+	}); err != nil {
+		return fmt.Errorf("deadLetter: create failed_events error: %w", err)
+	}
+	return nil
+}
+
+// halted 合约存在待处理的死信时同步暂停，返回是否暂停；只在暂停开始时记录日志
+func (t *Task) halted(ctx context.Context) (bool, error) {
+	fe, err := failedevents.GetFirstPending(ctx, t.DB, t.ChainID, t.Address)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if t.isHalted {
+			logx.Info(fmt.Sprintf("task %s resumed", t.Name()))
+			t.isHalted = false
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("halted: get failed_events error: %w", err)
+	}
+	if !t.isHalted {
+		logx.Error(fmt.Sprintf("task %s halted at failed event %d (%s, Block: %d, TxHash: %s, LogIndex: %d), run dlq retry or dlq drop to resume",
+			t.Name(), fe.ID, fe.EventName, fe.BlockNumber, fe.TransactionHash, fe.LogIndex))
+		t.isHalted = true
+	}
+	return true, nil
+}
+
+// isDropped 判断日志对应的死信是否已被运维丢弃，丢弃的日志不再执行 handler
+func (t *Task) isDropped(ctx context.Context, l ethereumTypes.Log) (bool, error) {
+	dropped, err := failedevents.IsDropped(ctx, t.DB, t.ChainID, l.TxHash.Hex(), int32(l.Index))
+	if err != nil {
+		return false, fmt.Errorf("isDropped: get failed_events error: %w", err)
+	}
+	return dropped, nil
+}
+
+// resolveFailedEvents 在当前事务（t.DB）中把同步进度之前重试中的死信标记为已解决
+func (t *Task) resolveFailedEvents(ctx context.Context, lastSyncedBlock uint64) error {
+	if err := failedevents.ResolveThrough(ctx, t.DB, t.ChainID, t.Address, lastSyncedBlock); err != nil {
+		return fmt.Errorf("resolveFailedEvents: update failed_events error: %w", err)
+	}
+	return nil
+}
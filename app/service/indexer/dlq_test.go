@@ -0,0 +1,124 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/failedevents"
+)
+
+const testContract = "0x1111111111111111111111111111111111111111"
+
+// testModule 只提供任务名称的模块
+type testModule struct{ Module }
+
+func (testModule) Name() string { return "test" }
+
+func newTestTask(t *testing.T) *Task {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec(`CREATE TABLE failed_events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		chain_id INT NOT NULL,
+		contract_address VARCHAR(42) NOT NULL,
+		event_name VARCHAR(100) NOT NULL,
+		handler_name VARCHAR(100) NOT NULL,
+		block_number BIGINT NOT NULL,
+		transaction_hash VARCHAR(66) NOT NULL,
+		log_index INT NOT NULL,
+		raw_log TEXT NOT NULL,
+		error_message TEXT NOT NULL,
+		attempts INT NOT NULL DEFAULT 1,
+		status VARCHAR(16) NOT NULL DEFAULT 'pending',
+		created_at TIMESTAMP,
+		updated_at TIMESTAMP,
+		UNIQUE (chain_id, transaction_hash, log_index)
+	)`).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &Task{DB: db, ChainID: 1, Address: testContract, ABI: &abi.ABI{}, Module: testModule{}}
+}
+
+func testHandlerError(txHash string, index uint) *handlerError {
+	return &handlerError{
+		Log: ethereumTypes.Log{
+			BlockNumber: 100,
+			TxHash:      ethCommon.HexToHash(txHash),
+			Index:       index,
+			Topics:      []ethCommon.Hash{{}},
+		},
+		HandlerName: "HandleDepositEvent",
+		Err:         errors.New("connection reset"),
+	}
+}
+
+func TestOnHandlerError(t *testing.T) {
+	ctx := context.Background()
+	task := newTestTask(t)
+	he := testHandlerError("0x01", 0)
+
+	// 达到最大次数之前退避后重试区间，不写入死信
+	for i := int32(1); i < handleMaxAttempts; i++ {
+		before := time.Now()
+		task.onHandlerError(ctx, he)
+		if task.failingAttempts != i {
+			t.Fatalf("attempt %d: failingAttempts = %d", i, task.failingAttempts)
+		}
+		if want := before.Add(retryBackoff(handleBaseBackoff, handleMaxBackoff, i)); task.pausedUntil.Before(want) {
+			t.Fatalf("attempt %d: pausedUntil = %s, want >= %s", i, task.pausedUntil, want)
+		}
+		if halted, err := task.halted(ctx); err != nil || halted {
+			t.Fatalf("attempt %d: halted = %v, %v, want false", i, halted, err)
+		}
+	}
+
+	// 其他日志失败时重新计数
+	other := newTestTask(t)
+	other.failingLog, other.failingAttempts = logKey(he.Log), handleMaxAttempts-1
+	other.onHandlerError(ctx, testHandlerError("0x02", 0))
+	if other.failingAttempts != 1 {
+		t.Fatalf("failingAttempts after another log failed = %d, want 1", other.failingAttempts)
+	}
+
+	// 达到最大次数后写入死信并暂停同步
+	task.onHandlerError(ctx, he)
+	if task.failingAttempts != 0 {
+		t.Fatalf("failingAttempts after dead-letter = %d, want 0", task.failingAttempts)
+	}
+	halted, err := task.halted(ctx)
+	if err != nil || !halted {
+		t.Fatalf("halted = %v, %v, want true", halted, err)
+	}
+	fe, err := failedevents.GetFirstPending(ctx, task.DB, 1, testContract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fe.TransactionHash != he.Log.TxHash.Hex() || fe.Attempts != handleMaxAttempts || fe.ErrorMessage != "connection reset" {
+		t.Fatalf("failed event = %s/%d/%s", fe.TransactionHash, fe.Attempts, fe.ErrorMessage)
+	}
+
+	// dlq retry 后恢复同步，同步进度越过该区块后标记为已解决
+	if _, err := failedevents.MarkRetrying(ctx, task.DB, []int64{fe.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if halted, err := task.halted(ctx); err != nil || halted {
+		t.Fatalf("halted after retry = %v, %v, want false", halted, err)
+	}
+	if err := task.resolveFailedEvents(ctx, 100); err != nil {
+		t.Fatal(err)
+	}
+	if fe, _ = failedevents.GetByID(ctx, task.DB, fe.ID); fe.Status != failedevents.StatusResolved {
+		t.Fatalf("status = %s, want %s", fe.Status, failedevents.StatusResolved)
+	}
+}
@@ -0,0 +1,153 @@
+package indexer
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncleases"
+)
+
+const (
+	leaseTTL           = 30 * time.Second // 租约有效期，持有者异常退出后最多等待该时间即可被其他进程取得
+	leaseRenewInterval = 10 * time.Second // 续期间隔
+)
+
+// errLeaseHeld 租约由其他进程持有
+var errLeaseHeld = errors.New("contract is being synced by another process")
+
+// syncLease 合约同步租约。同一合约的派生表同时只能由一个进程写入：daemon 的同步任务在后台持续持有，
+// backfill 等命令执行期间持有；每个写入事务都会在事务内校验租约，租约丢失的进程无法提交
+type syncLease struct {
+	owner string
+	held  atomic.Bool
+}
+
+func newSyncLease(role string) *syncLease {
+	host, _ := os.Hostname()
+	suffix := make([]byte, 4)
+	_, _ = rand.Read(suffix)
+	return &syncLease{owner: fmt.Sprintf("%s-%d-%s-%s", host, os.Getpid(), role, hex.EncodeToString(suffix))}
+}
+
+// acquireLease 获取或续期租约，租约由其他进程持有时返回 errLeaseHeld。
+// 续期在后台协程中执行，db 需由调用方传入，不能读取处理区间时会被替换为事务的 t.DB
+func (t *Task) acquireLease(ctx context.Context, db *gorm.DB) error {
+	now := time.Now()
+	lease, err := syncleases.Acquire(ctx, db, t.ChainID, t.Address, t.lease.owner, now, now.Add(leaseTTL))
+	if err != nil {
+		t.lease.held.Store(false)
+		return fmt.Errorf("acquireLease: update sync_leases error: %w", err)
+	}
+	if lease.Owner != t.lease.owner {
+		t.lease.held.Store(false)
+		return fmt.Errorf("%w: %s, lease expires at %s", errLeaseHeld, lease.Owner, lease.ExpiresAt.Format(time.RFC3339))
+	}
+	t.lease.held.Store(true)
+	return nil
+}
+
+// checkLease 在写入派生表的事务中校验租约，租约丢失时返回错误使事务回滚
+func (t *Task) checkLease(ctx context.Context, tx *gorm.DB) error {
+	if err := syncleases.Check(ctx, tx, t.ChainID, t.Address, t.lease.owner, time.Now()); err != nil {
+		if errors.Is(err, syncleases.ErrLeaseLost) {
+			t.lease.held.Store(false)
+		}
+		return fmt.Errorf("checkLease: %w", err)
+	}
+	return nil
+}
+
+func (t *Task) releaseLease() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	t.lease.held.Store(false)
+	if err := syncleases.Release(ctx, t.DB, t.ChainID, t.Address, t.lease.owner); err != nil {
+		logx.Error("releaseLease: delete sync_leases error: ", err)
+	}
+}
+
+// holdLease daemon 同步任务后台获取并续期租约，其他进程（如 backfill）持有期间等待，任务停止时释放
+func (t *Task) holdLease() {
+	waiting := false
+	for {
+		ctx, cancel := context.WithTimeout(t.Context, leaseRenewInterval)
+		err := t.acquireLease(ctx, t.DB)
+		cancel()
+		switch {
+		case err == nil:
+			if waiting {
+				logx.Info(fmt.Sprintf("task %s acquired sync lease", t.Name()))
+				waiting = false
+			}
+		case errors.Is(err, errLeaseHeld):
+			// 只在开始等待时记录一次
+			if !waiting {
+				logx.Info(fmt.Sprintf("task %s waiting for sync lease: %v", t.Name(), err))
+				waiting = true
+			}
+		default:
+			logx.Error(fmt.Sprintf("task %s renew sync lease error: %v", t.Name(), err))
+		}
+
+		select {
+		case <-t.Context.Done():
+			t.releaseLease()
+			logx.Info(fmt.Sprintf("lease holder %s stopped", t.Name()))
+			return
+		case <-time.After(leaseRenewInterval):
+		}
+	}
+}
+
+// WithLease 以 role 身份取得租约后执行 fn，期间后台续期，结束后释放。供 backfill 等命令在 daemon 之外写入派生表，
+// 租约由其他进程（通常是正在运行的 daemon）持有时直接返回错误；执行期间租约丢失时取消 fn 的 ctx
+func (t *Task) WithLease(ctx context.Context, role string, fn func(ctx context.Context) error) error {
+	t.lease = newSyncLease(role)
+	db := t.DB
+	if err := t.acquireLease(ctx, db); err != nil {
+		if errors.Is(err, errLeaseHeld) {
+			return fmt.Errorf("%w; stop the daemon for this contract first", err)
+		}
+		return err
+	}
+	defer t.releaseLease()
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	// 先停止续期再释放租约
+	defer func() {
+		cancel()
+		<-done
+	}()
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(leaseRenewInterval):
+			}
+			renewCtx, cancelRenew := context.WithTimeout(ctx, leaseRenewInterval)
+			err := t.acquireLease(renewCtx, db)
+			cancelRenew()
+			if errors.Is(err, errLeaseHeld) {
+				logx.Error(fmt.Sprintf("%s: sync lease lost: %v", t.Name(), err))
+				cancel()
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				logx.Error(fmt.Sprintf("%s: renew sync lease error: %v", t.Name(), err))
+			}
+		}
+	}()
+	return fn(ctx)
+}
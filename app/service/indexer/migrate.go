@@ -0,0 +1,115 @@
+package indexer
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/schemamigrations"
+)
+
+//go:embed migrations/001_sync_leases.sql
+var migrationSyncLeasesSQL string
+
+//go:embed migrations/002_chain_id.sql
+var migrationChainIDSQL string
+
+//go:embed migrations/003_sync_mode.sql
+var migrationSyncModeSQL string
+
+//go:embed migrations/004_chain_endpoints.sql
+var migrationChainEndpointsSQL string
+
+//go:embed migrations/005_failed_events_retry.sql
+var migrationFailedEventsRetrySQL string
+
+// engineMigrationModule 同步引擎自有表的迁移在 schema_migrations 中记录的模块名称
+const engineMigrationModule = "indexer"
+
+// engineMigrations 同步引擎自有表（不属于任何合约模块）的迁移
+func engineMigrations() []Migration {
+	return []Migration{
+		{Version: 1, Description: "create sync_leases", SQL: migrationSyncLeasesSQL},
+		{Version: 2, Description: "add chain_id to contract_events and reorg_snapshots", SQL: migrationChainIDSQL},
+		{Version: 3, Description: "add sync_mode and confirmations to chain_contracts", SQL: migrationSyncModeSQL},
+		{Version: 4, Description: "allow multiple chain_endpoints per chain", SQL: migrationChainEndpointsSQL},
+		{Version: 5, Description: "drop failed_events.next_retry_at", SQL: migrationFailedEventsRetrySQL},
+	}
+}
+
+// Migrate 先执行同步引擎的迁移，再按版本顺序执行所有已注册模块尚未执行的迁移。
+// MySQL 的 DDL 会隐式提交，迁移语句应可重复执行（如 CREATE TABLE IF NOT EXISTS），中途失败后可直接重跑
+func Migrate(ctx context.Context, db *gorm.DB) error {
+	if err := migrate(ctx, db, engineMigrationModule, engineMigrations()); err != nil {
+		return err
+	}
+	for _, m := range Modules() {
+		if err := migrate(ctx, db, m.Name(), m.Migrations()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrate 执行 module 尚未执行的迁移并记录到 schema_migrations
+func migrate(ctx context.Context, db *gorm.DB, module string, migrations []Migration) error {
+	applied, err := schemamigrations.ListVersionsByModule(ctx, db, module)
+	if err != nil {
+		return fmt.Errorf("Migrate: list schema_migrations of %s error: %w", module, err)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	for _, mg := range migrations {
+		if applied[mg.Version] {
+			continue
+		}
+		// 同一迁移的语句在同一连接上执行，可以使用会话变量和预处理语句实现按条件执行的 DDL
+		if err := db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+			for _, stmt := range splitStatements(mg.SQL) {
+				if err := conn.Exec(stmt).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("Migrate: %s migration %d error: %w", module, mg.Version, err)
+		}
+		if err := schemamigrations.Create(ctx, db, &model.SchemaMigration{
+			Module:      module,
+			Version:     mg.Version,
+			Description: mg.Description,
+		}); err != nil {
+			return fmt.Errorf("Migrate: create schema_migrations error: %w", err)
+		}
+		logx.Info(fmt.Sprintf("migration applied, module: %s, version: %d, %s", module, mg.Version, mg.Description))
+	}
+	return nil
+}
+
+// splitStatements 按行尾分号拆分 SQL 语句，忽略空行和 -- 注释行
+func splitStatements(sql string) []string {
+	var stmts []string
+	var sb strings.Builder
+	for _, line := range strings.Split(sql, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		sb.WriteString(line)
+		sb.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			stmts = append(stmts, strings.TrimSpace(sb.String()))
+			sb.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(sb.String()); rest != "" {
+		stmts = append(stmts, rest)
+	}
+	return stmts
+}
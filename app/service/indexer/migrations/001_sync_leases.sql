@@ -0,0 +1,13 @@
+-- ========================================
+-- 同步租约表：同一合约同时只有一个进程（daemon 同步任务或 backfill 等命令）写入派生表
+-- ========================================
+CREATE TABLE IF NOT EXISTS sync_leases (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    chain_id INT NOT NULL COMMENT '链ID',
+    contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    owner VARCHAR(128) NOT NULL COMMENT '租约持有者 (主机名-进程号-角色)',
+    expires_at TIMESTAMP NOT NULL COMMENT '租约过期时间，持有者定期续期',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_chain_contract (chain_id, contract_address)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='同步租约表';
@@ -0,0 +1,33 @@
+-- ========================================
+-- contract_events / reorg_snapshots 增加 chain_id，failed_events 唯一键加上 chain_id
+-- 已有记录的 chain_id 取自 chain_contracts（同一地址注册在多条链上时需人工核对）。
+-- 列不存在时才添加，按新的 sql/database_schema.sql 建表的数据库执行时只重建唯一键
+-- ========================================
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'contract_events' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE contract_events ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE contract_events t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE contract_events ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE contract_events DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'reorg_snapshots' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE reorg_snapshots ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE reorg_snapshots t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE reorg_snapshots ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE reorg_snapshots DROP INDEX uk_contract_block_scope, ADD UNIQUE KEY uk_contract_block_scope (chain_id, contract_address, block_number, target_table, scope);
+
+ALTER TABLE failed_events DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
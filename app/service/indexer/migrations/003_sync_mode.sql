@@ -0,0 +1,20 @@
+-- ========================================
+-- chain_contracts 增加同步高度模式和确认数，已有合约保持原来的行为（latest，不等待确认）。
+-- 列不存在时才添加（按新的 sql/base.sql 建表时已包含这些列）
+-- ========================================
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_contracts' AND COLUMN_NAME = 'sync_mode') = 0,
+    'ALTER TABLE chain_contracts ADD COLUMN sync_mode VARCHAR(16) NOT NULL DEFAULT ''latest'' COMMENT ''同步高度模式 (latest / confirmations / safe / finalized)'' AFTER abi',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_contracts' AND COLUMN_NAME = 'confirmations') = 0,
+    'ALTER TABLE chain_contracts ADD COLUMN confirmations INT NOT NULL DEFAULT 0 COMMENT ''confirmations 模式下落后最新区块的确认数'' AFTER sync_mode',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
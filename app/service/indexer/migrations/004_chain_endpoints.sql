@@ -0,0 +1,52 @@
+-- ========================================
+-- chain_endpoints 支持同一条链配置多个端点：去掉 chain_id 上的唯一键，增加优先级和限流列。
+-- 列和索引不存在时才添加、存在时才删除（按新的 sql/base.sql 建表时已是新结构）
+-- ========================================
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.STATISTICS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_endpoints' AND INDEX_NAME = 'chain_id') > 0,
+    'ALTER TABLE chain_endpoints DROP INDEX chain_id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.STATISTICS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_endpoints' AND INDEX_NAME = 'uk_chain_id') > 0,
+    'ALTER TABLE chain_endpoints DROP INDEX uk_chain_id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_endpoints' AND COLUMN_NAME = 'priority') = 0,
+    'ALTER TABLE chain_endpoints ADD COLUMN priority INT NOT NULL DEFAULT 0 COMMENT ''优先级，越小越优先'' AFTER url',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_endpoints' AND COLUMN_NAME = 'rate_limit') = 0,
+    'ALTER TABLE chain_endpoints ADD COLUMN rate_limit INT NOT NULL DEFAULT 0 COMMENT ''每秒最多请求数，0 表示不限制'' AFTER priority',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.STATISTICS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_endpoints' AND INDEX_NAME = 'uk_chain_url') = 0,
+    'ALTER TABLE chain_endpoints ADD UNIQUE KEY uk_chain_url (chain_id, url)',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.STATISTICS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'chain_endpoints' AND INDEX_NAME = 'idx_chain_priority') = 0,
+    'ALTER TABLE chain_endpoints ADD INDEX idx_chain_priority (chain_id, priority)',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
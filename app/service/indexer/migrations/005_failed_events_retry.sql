@@ -0,0 +1,12 @@
+-- ========================================
+-- failed_events 去掉 next_retry_at：存在待处理的失败事件时暂停该合约的同步，失败事件只通过 dlq retry 命令重试，
+-- 不会按时间自动重试。列存在时才删除（按新的 sql/database_schema.sql 建表时已不包含该列）
+-- ========================================
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'failed_events' AND COLUMN_NAME = 'next_retry_at') > 0,
+    'ALTER TABLE failed_events DROP COLUMN next_retry_at',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
@@ -0,0 +1,103 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+// Module 一种合约类型的处理模块：提供 ABI、事件处理函数和自有表的迁移。
+// 各模块在 init 中通过 Register 注册到 chain_contracts.contract_name 上，
+// 同步引擎（同步进度、重组回滚、死信队列、回填、订阅）由 Task 统一实现
+type Module interface {
+	// ContractName chain_contracts.contract_name 中该合约类型的标识符
+	ContractName() int32
+	// Name 模块名称，用于任务名称和日志
+	Name() string
+	// ABI 合约 ABI，chain_contracts.abi 为空时使用
+	ABI() string
+	// Migrations 模块自有表的迁移，按 Version 升序执行一次
+	Migrations() []Migration
+	// NewHandler 为一个同步任务创建事件处理器，处理器通过 t.DB 读写数据库（处理区间时为当前事务）
+	NewHandler(t *Task) (ContractHandler, error)
+}
+
+// ContractHandler 一个合约的事件处理器
+type ContractHandler interface {
+	// EventHandlers 按 ABI 事件名称索引的事件处理函数，未注册的事件只记录到 contract_events
+	EventHandlers() map[string]EventHandler
+	// Snapshot 处理区块的第一条日志前保存该区块可能修改的派生表行（Task.SaveSnapshot），logs 为该区块内本合约的日志
+	Snapshot(ctx context.Context, blockNumber uint64, logs []ethereumTypes.Log) error
+	// Restore 链重组时在事务 tx 中把派生表恢复为快照内容
+	Restore(ctx context.Context, tx *gorm.DB, s *model.ReorgSnapshot) error
+	// Rollback 链重组时在事务 tx 中删除公共祖先之后区块写入的事件表数据
+	Rollback(ctx context.Context, tx *gorm.DB, ancestor uint64) error
+	// Reset 全量重建时在事务 tx 中删除本合约的全部派生表数据，之后从第一个区块重新处理
+	Reset(ctx context.Context, tx *gorm.DB) error
+}
+
+// RangePreparer ContractHandler 可选实现的接口：处理每个区间（包括没有日志的区间）前在同一事务中调用，
+// 用于初始化不由事件维护的派生状态，失败时整个区间回滚重试
+type RangePreparer interface {
+	PrepareRange(ctx context.Context) error
+}
+
+// RangeFinisher ContractHandler 可选实现的接口：区间内的日志全部处理后、保存同步进度前在同一事务中调用，
+// logs 为区间内本合约的日志，endBlock 为区间末尾区块，失败时整个区间回滚重试
+type RangeFinisher interface {
+	FinishRange(ctx context.Context, logs []ethereumTypes.Log, endBlock uint64) error
+}
+
+// EventHandler 事件处理函数及其名称（名称记录在死信队列中）
+type EventHandler struct {
+	Name   string
+	Handle func(ethereumTypes.Log) error
+}
+
+// Migration 模块的一次表结构迁移，SQL 可包含多条以分号结尾的语句，执行后记录到 schema_migrations
+type Migration struct {
+	Version     int32
+	Description string
+	SQL         string
+}
+
+var (
+	modulesMu sync.RWMutex
+	modules   = make(map[int32]Module)
+)
+
+// Register 注册合约处理模块，同一合约类型重复注册时 panic
+func Register(m Module) {
+	modulesMu.Lock()
+	defer modulesMu.Unlock()
+	if _, ok := modules[m.ContractName()]; ok {
+		panic(fmt.Sprintf("indexer: module for contract name %d already registered", m.ContractName()))
+	}
+	modules[m.ContractName()] = m
+}
+
+// Lookup 按 chain_contracts.contract_name 查找已注册的模块
+func Lookup(contractName int32) (Module, bool) {
+	modulesMu.RLock()
+	defer modulesMu.RUnlock()
+	m, ok := modules[contractName]
+	return m, ok
+}
+
+// Modules 返回所有已注册的模块，按合约类型排序
+func Modules() []Module {
+	modulesMu.RLock()
+	defer modulesMu.RUnlock()
+	res := make([]Module, 0, len(modules))
+	for _, m := range modules {
+		res = append(res, m)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ContractName() < res[j].ContractName() })
+	return res
+}
@@ -0,0 +1,42 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// EventsChannel 合约事件提交或因重组回滚后发布通知的 Redis 频道
+const EventsChannel = "stake_sync:contract_events"
+
+// EventsNotification 事件变更通知，只用于唤醒订阅方，事件内容由订阅方从 contract_events 读取
+type EventsNotification struct {
+	ChainID  int32  `json:"chain_id"`
+	Contract string `json:"contract"`
+	Block    uint64 `json:"block"`    // 已提交区间的末尾区块；回滚时为公共祖先
+	Rollback bool   `json:"rollback"` // 公共祖先之后的事件已被删除
+}
+
+// notifyEvents 在事务提交后唤醒本任务的发件箱 relay 和 webhook 投递，并发布事件变更通知。
+// 通知是尽力而为的，发布失败只记录日志，订阅方需自行兜底轮询
+func (t *Task) notifyEvents(ctx context.Context, block uint64, rollback bool) {
+	select {
+	case t.outboxWakeup <- struct{}{}:
+	default:
+	}
+	select {
+	case t.webhookWakeup <- struct{}{}:
+	default:
+	}
+
+	payload, err := json.Marshal(EventsNotification{ChainID: t.ChainID, Contract: t.Address, Block: block, Rollback: rollback})
+	if err != nil {
+		logx.Error(fmt.Sprintf("notifyEvents: marshal notification error: %v", err))
+		return
+	}
+	if err := t.RedisClient.Publish(ctx, EventsChannel, payload).Err(); err != nil {
+		logx.Info(fmt.Sprintf("notifyEvents: publish to %s error: %v", EventsChannel, err))
+	}
+}
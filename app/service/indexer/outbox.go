@@ -0,0 +1,255 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/outbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/eventoutbox"
+)
+
+const (
+	defaultOutboxBatchSize    = 200
+	defaultOutboxPollInterval = 5 * time.Second
+	defaultOutboxRetention    = 7 * 24 * time.Hour
+	outboxCleanupInterval     = time.Hour
+)
+
+// eventPayload 构建新保存事件的消息内容，供事件发件箱和 webhook 使用
+func (t *Task) eventPayload(ev *model.ContractEvent, l ethereumTypes.Log) *outbox.Payload {
+	payload := outboxPayload(t.ChainID, eventoutbox.KindEvent, ev)
+	args, err := t.decodeEventArgs(l)
+	if err != nil {
+		// 解码失败不影响同步，消费方仍可使用原始 topics 和 data
+		logx.Error(fmt.Sprintf("eventPayload: decode %s args error, TxHash=%s, LogIndex=%d: %v", ev.EventName, ev.TransactionHash, ev.LogIndex, err))
+	}
+	payload.Args = args
+	return payload
+}
+
+// saveOutboxEvent 在当前事务（t.DB）中为新保存的事件写入发件箱消息
+func (t *Task) saveOutboxEvent(ctx context.Context, ev *model.ContractEvent, payload *outbox.Payload) error {
+	if t.Sink == nil {
+		return nil
+	}
+	item, err := outboxItem(t.ChainID, eventoutbox.KindEvent, ev, payload)
+	if err != nil {
+		return err
+	}
+	if err := eventoutbox.CreateBatch(ctx, t.DB, []*model.EventOutbox{item}); err != nil {
+		return fmt.Errorf("saveOutboxEvent: create event_outbox error: %w", err)
+	}
+	return nil
+}
+
+// saveOutboxRetractions 在回滚事务中为公共祖先之后的事件写入撤回消息，按与原事件相反的顺序排列
+func (t *Task) saveOutboxRetractions(ctx context.Context, tx *gorm.DB, ancestor uint64) error {
+	if t.Sink == nil {
+		return nil
+	}
+	events, err := contractevents.ListAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor)
+	if err != nil {
+		return fmt.Errorf("saveOutboxRetractions: list contract_events error: %w", err)
+	}
+	items := make([]*model.EventOutbox, 0, len(events))
+	for _, ev := range events {
+		item, err := outboxItem(t.ChainID, eventoutbox.KindRetract, ev, outboxPayload(t.ChainID, eventoutbox.KindRetract, ev))
+		if err != nil {
+			return err
+		}
+		items = append(items, item)
+	}
+	if err := eventoutbox.CreateBatch(ctx, tx, items); err != nil {
+		return fmt.Errorf("saveOutboxRetractions: create event_outbox error: %w", err)
+	}
+	return nil
+}
+
+func outboxPayload(chainID int32, kind string, ev *model.ContractEvent) *outbox.Payload {
+	p := &outbox.Payload{
+		Kind:            kind,
+		ChainID:         chainID,
+		Contract:        ev.ContractAddress,
+		Event:           ev.EventName,
+		BlockNumber:     ev.BlockNumber,
+		BlockTimestamp:  ev.BlockTimestamp,
+		TransactionHash: ev.TransactionHash,
+		LogIndex:        ev.LogIndex,
+		Topics:          []string{ev.Topic0},
+	}
+	for _, topic := range []*string{ev.Topic1, ev.Topic2, ev.Topic3} {
+		if topic != nil {
+			p.Topics = append(p.Topics, *topic)
+		}
+	}
+	if ev.Data != nil {
+		p.Data = *ev.Data
+	}
+	return p
+}
+
+func outboxItem(chainID int32, kind string, ev *model.ContractEvent, payload *outbox.Payload) (*model.EventOutbox, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("outboxItem: marshal payload error: %w", err)
+	}
+	return &model.EventOutbox{
+		ChainID:         chainID,
+		ContractAddress: ev.ContractAddress,
+		Kind:            kind,
+		EventName:       ev.EventName,
+		BlockNumber:     ev.BlockNumber,
+		TransactionHash: ev.TransactionHash,
+		LogIndex:        ev.LogIndex,
+		Payload:         string(data),
+	}, nil
+}
+
+// decodeEventArgs 按 ABI 解码事件的全部参数，ABI 中没有该事件时返回 nil
+func (t *Task) decodeEventArgs(l ethereumTypes.Log) (map[string]interface{}, error) {
+	ev, err := t.ABI.EventByID(l.Topics[0])
+	if err != nil {
+		return nil, nil
+	}
+	args := make(map[string]interface{})
+	if err := ev.Inputs.UnpackIntoMap(args, l.Data); err != nil {
+		return nil, err
+	}
+	var indexed abi.Arguments
+	for _, input := range ev.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(args, indexed, l.Topics[1:]); err != nil {
+		return nil, err
+	}
+	// 大整数按十进制字符串输出，避免消费方按浮点数解析丢失精度；bytes32 按十六进制输出
+	for k, v := range args {
+		switch v := v.(type) {
+		case *big.Int:
+			args[k] = v.String()
+		case [32]byte:
+			args[k] = hexutil.Encode(v[:])
+		}
+	}
+	return args, nil
+}
+
+// relayOutbox 后台按写入顺序把本合约的发件箱消息发布到消息总线，发布失败时从失败的消息开始重试，保证合约内顺序
+func (t *Task) relayOutbox() {
+	var nextCleanup time.Time
+	for {
+		n, err := t.publishOutbox()
+		if err != nil {
+			logx.Error(fmt.Sprintf("relayOutbox %s: %v", t.Name(), err))
+		}
+		if time.Now().After(nextCleanup) {
+			t.cleanupOutbox()
+			nextCleanup = time.Now().Add(outboxCleanupInterval)
+		}
+		// 还有积压时立即继续发布
+		if err == nil && n == t.outboxBatchSize() {
+			continue
+		}
+
+		select {
+		case <-t.Context.Done():
+			logx.Info(fmt.Sprintf("outbox relay %s stopped", t.Name()))
+			return
+		case <-t.outboxWakeup:
+		case <-time.After(t.outboxPollInterval()):
+		}
+	}
+}
+
+// publishOutbox 发布一批未发布的消息并标记为已发布，返回本批读取的消息数
+func (t *Task) publishOutbox() (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	items, err := eventoutbox.ListUnpublished(ctx, t.DB, t.ChainID, t.Address, t.outboxBatchSize())
+	if err != nil {
+		return 0, fmt.Errorf("publishOutbox: list event_outbox error: %w", err)
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+
+	msgs := make([]*outbox.Message, 0, len(items))
+	for _, item := range items {
+		msgs = append(msgs, &outbox.Message{
+			ID:       item.ID,
+			ChainID:  item.ChainID,
+			Contract: item.ContractAddress,
+			Kind:     item.Kind,
+			Event:    item.EventName,
+			Block:    item.BlockNumber,
+			Payload:  []byte(item.Payload),
+		})
+	}
+	published, errPublish := t.Sink.Publish(ctx, msgs)
+
+	ids := make([]int64, 0, published)
+	for _, item := range items[:published] {
+		ids = append(ids, item.ID)
+	}
+	// 标记失败时消息会被重复发布，消费方按消息 ID 去重
+	if err := eventoutbox.MarkPublished(ctx, t.DB, ids, time.Now()); err != nil {
+		return 0, fmt.Errorf("publishOutbox: mark event_outbox published error: %w", err)
+	}
+	if errPublish != nil {
+		if err := eventoutbox.MarkFailed(ctx, t.DB, items[published].ID, errPublish.Error()); err != nil {
+			logx.Error("publishOutbox: update event_outbox error: ", err)
+		}
+		return published, fmt.Errorf("publishOutbox: publish to %s error: %w", t.Sink.Name(), errPublish)
+	}
+	return len(items), nil
+}
+
+// cleanupOutbox 清理超过保留时间的已发布消息
+func (t *Task) cleanupOutbox() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	deleted, err := eventoutbox.DeletePublishedBefore(ctx, t.DB, t.ChainID, t.Address, time.Now().Add(-t.outboxRetention()))
+	if err != nil {
+		logx.Error("cleanupOutbox: delete event_outbox error: ", err)
+		return
+	}
+	if deleted > 0 {
+		logx.Info(fmt.Sprintf("cleanupOutbox %s: deleted %d published messages", t.Name(), deleted))
+	}
+}
+
+func (t *Task) outboxBatchSize() int {
+	if t.Config != nil && t.Config.Outbox != nil && t.Config.Outbox.BatchSize > 0 {
+		return t.Config.Outbox.BatchSize
+	}
+	return defaultOutboxBatchSize
+}
+
+func (t *Task) outboxPollInterval() time.Duration {
+	if t.Config != nil && t.Config.Outbox != nil && t.Config.Outbox.PollInterval > 0 {
+		return t.Config.Outbox.PollInterval
+	}
+	return defaultOutboxPollInterval
+}
+
+func (t *Task) outboxRetention() time.Duration {
+	if t.Config != nil && t.Config.Outbox != nil && t.Config.Outbox.Retention > 0 {
+		return t.Config.Outbox.Retention
+	}
+	return defaultOutboxRetention
+}
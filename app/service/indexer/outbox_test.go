@@ -0,0 +1,471 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/outbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/eventoutbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncleases"
+)
+
+const testOutboxABI = `[{"type":"event","name":"Deposit","anonymous":false,"inputs":[
+	{"name":"user","type":"address","indexed":true},
+	{"name":"poolId","type":"uint256","indexed":true},
+	{"name":"amount","type":"uint256","indexed":false}]}]`
+
+// fakeSink 按顺序记录发布的消息，failAt 条之后返回错误（failAt < 0 时全部成功）
+type fakeSink struct {
+	published []*outbox.Message
+	failAt    int
+}
+
+func (s *fakeSink) Name() string { return "fake" }
+
+func (s *fakeSink) Publish(_ context.Context, msgs []*outbox.Message) (int, error) {
+	for i, m := range msgs {
+		if s.failAt >= 0 && len(s.published) >= s.failAt {
+			return i, errors.New("connection refused")
+		}
+		s.published = append(s.published, m)
+	}
+	return len(msgs), nil
+}
+
+// noopHandler 不维护派生表的事件处理器
+type noopHandler struct{}
+
+func (noopHandler) EventHandlers() map[string]EventHandler { return nil }
+func (noopHandler) Snapshot(context.Context, uint64, []ethereumTypes.Log) error {
+	return nil
+}
+func (noopHandler) Restore(context.Context, *gorm.DB, *model.ReorgSnapshot) error { return nil }
+func (noopHandler) Rollback(context.Context, *gorm.DB, uint64) error              { return nil }
+func (noopHandler) Reset(context.Context, *gorm.DB) error                         { return nil }
+
+// newOutboxTestTask 在 newTestTask 的基础上建立区间提交和重组回滚涉及的表，并持有同步租约
+func newOutboxTestTask(t *testing.T) (*Task, *fakeSink) {
+	task := newTestTask(t)
+	for _, ddl := range []string{
+		`CREATE TABLE contract_events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(255) NOT NULL,
+			event_name VARCHAR(100) NOT NULL,
+			topic0 VARCHAR(255) NOT NULL,
+			topic1 VARCHAR(255),
+			topic2 VARCHAR(255),
+			topic3 VARCHAR(255),
+			data TEXT,
+			block_number BIGINT NOT NULL,
+			block_timestamp BIGINT NOT NULL,
+			transaction_hash VARCHAR(255) NOT NULL,
+			log_index INT NOT NULL,
+			created_at TIMESTAMP,
+			UNIQUE (chain_id, transaction_hash, log_index)
+		)`,
+		`CREATE TABLE event_outbox (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			kind VARCHAR(16) NOT NULL,
+			event_name VARCHAR(100) NOT NULL,
+			block_number BIGINT NOT NULL,
+			transaction_hash VARCHAR(66) NOT NULL,
+			log_index INT NOT NULL,
+			payload TEXT NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			last_error TEXT,
+			published_at TIMESTAMP,
+			created_at TIMESTAMP
+		)`,
+		`CREATE TABLE sync_leases (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			owner VARCHAR(128) NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE (chain_id, contract_address)
+		)`,
+		`CREATE TABLE sync_blocks (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			block_number BIGINT NOT NULL,
+			block_hash VARCHAR(66) NOT NULL,
+			parent_hash VARCHAR(66) NOT NULL,
+			created_at TIMESTAMP,
+			UNIQUE (chain_id, contract_address, block_number)
+		)`,
+		`CREATE TABLE reorg_snapshots (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			block_number BIGINT NOT NULL,
+			target_table VARCHAR(64) NOT NULL,
+			scope VARCHAR(42) NOT NULL,
+			snapshot_data TEXT NOT NULL,
+			created_at TIMESTAMP,
+			UNIQUE (chain_id, contract_address, block_number, target_table, scope)
+		)`,
+		`CREATE TABLE sync_status (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			contract_address VARCHAR(42) NOT NULL,
+			chain_id INT NOT NULL,
+			last_synced_block BIGINT NOT NULL,
+			last_sync_time TIMESTAMP,
+			sync_error TEXT,
+			is_syncing TINYINT(1) DEFAULT 0,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE (contract_address, chain_id)
+		)`,
+		`CREATE TABLE webhook_deliveries (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			subscription_id BIGINT NOT NULL,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			event_name VARCHAR(100) NOT NULL,
+			block_number BIGINT NOT NULL,
+			transaction_hash VARCHAR(66) NOT NULL,
+			log_index INT NOT NULL,
+			payload TEXT NOT NULL,
+			status VARCHAR(16) NOT NULL DEFAULT 'pending',
+			attempts INT NOT NULL DEFAULT 0,
+			response_status INT,
+			last_error TEXT,
+			next_retry_at TIMESTAMP,
+			delivered_at TIMESTAMP,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE (subscription_id, transaction_hash, log_index)
+		)`,
+	} {
+		if err := task.DB.Exec(ddl).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(testOutboxABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := &fakeSink{failAt: -1}
+	task.ABI = &contractABI
+	task.Sink = sink
+	task.handler = noopHandler{}
+	task.lease = newSyncLease("test")
+	now := time.Now()
+	if _, err := syncleases.Acquire(context.Background(), task.DB, task.ChainID, task.Address, task.lease.owner, now, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	return task, sink
+}
+
+// testDepositLog 构造 Deposit(user, poolId, amount) 日志，区块哈希取自 header
+func testDepositLog(t *testing.T, task *Task, header *ethereumTypes.Header, txHash string, index uint, amount int64) ethereumTypes.Log {
+	ev := task.ABI.Events["Deposit"]
+	data, err := ev.Inputs.NonIndexed().Pack(big.NewInt(amount))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ethereumTypes.Log{
+		Address:     ethCommon.HexToAddress(task.Address),
+		Topics:      []ethCommon.Hash{ev.ID, ethCommon.HexToHash("0xabc"), ethCommon.BigToHash(big.NewInt(0))},
+		Data:        data,
+		BlockNumber: header.Number.Uint64(),
+		BlockHash:   header.Hash(),
+		TxHash:      ethCommon.HexToHash(txHash),
+		Index:       index,
+	}
+}
+
+func testHeader(number uint64) *ethereumTypes.Header {
+	return &ethereumTypes.Header{Number: new(big.Int).SetUint64(number), Time: 1700000000 + number*12}
+}
+
+func listOutbox(t *testing.T, task *Task) []*model.EventOutbox {
+	var items []*model.EventOutbox
+	if err := task.DB.Order("id ASC").Find(&items).Error; err != nil {
+		t.Fatal(err)
+	}
+	return items
+}
+
+func noCheckpoint(context.Context) error { return nil }
+
+func TestOutboxEnqueueInTransaction(t *testing.T) {
+	ctx := context.Background()
+	task, _ := newOutboxTestTask(t)
+	h10 := testHeader(10)
+	logs := []ethereumTypes.Log{
+		testDepositLog(t, task, h10, "0x01", 0, 100),
+		testDepositLog(t, task, h10, "0x01", 1, 200),
+	}
+
+	// 区间事务失败时发件箱消息与事件一起回滚
+	errCheckpoint := errors.New("save checkpoint failed")
+	err := task.applyRange(ctx, logs, []*ethereumTypes.Header{h10}, 10, func(context.Context) error { return errCheckpoint })
+	if !errors.Is(err, errCheckpoint) {
+		t.Fatalf("applyRange() error = %v, want %v", err, errCheckpoint)
+	}
+	if items := listOutbox(t, task); len(items) != 0 {
+		t.Fatalf("event_outbox has %d rows after rollback, want 0", len(items))
+	}
+
+	// 提交后每个事件一条消息，按日志顺序写入
+	if err := task.applyRange(ctx, logs, []*ethereumTypes.Header{h10}, 10, noCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+	items := listOutbox(t, task)
+	if len(items) != 2 {
+		t.Fatalf("event_outbox has %d rows, want 2", len(items))
+	}
+	for i, item := range items {
+		if item.Kind != eventoutbox.KindEvent || item.EventName != "Deposit" || item.LogIndex != int32(i) || item.PublishedAt != nil {
+			t.Fatalf("item %d = %s/%s/%d/%v", i, item.Kind, item.EventName, item.LogIndex, item.PublishedAt)
+		}
+	}
+	var payload outbox.Payload
+	if err := json.Unmarshal([]byte(items[1].Payload), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.BlockTimestamp != h10.Time || len(payload.Topics) != 3 || payload.Args["amount"] != "200" {
+		t.Fatalf("payload = %+v", payload)
+	}
+
+	// 已处理的日志重新提交时不重复写入
+	if err := task.applyRange(ctx, logs, []*ethereumTypes.Header{h10}, 10, noCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+	if items := listOutbox(t, task); len(items) != 2 {
+		t.Fatalf("event_outbox has %d rows after reapply, want 2", len(items))
+	}
+}
+
+func TestPublishOutboxInOrder(t *testing.T) {
+	ctx := context.Background()
+	task, sink := newOutboxTestTask(t)
+	h10, h11 := testHeader(10), testHeader(11)
+	logs := []ethereumTypes.Log{
+		testDepositLog(t, task, h10, "0x01", 0, 100),
+		testDepositLog(t, task, h11, "0x02", 0, 200),
+		testDepositLog(t, task, h11, "0x02", 1, 300),
+	}
+	if err := task.applyRange(ctx, logs, []*ethereumTypes.Header{h10, h11}, 11, noCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	// 第二条发布失败：只标记第一条，失败的消息记录错误并保持未发布
+	sink.failAt = 1
+	n, err := task.publishOutbox()
+	if err == nil || n != 1 {
+		t.Fatalf("publishOutbox() = %d, %v, want 1 and an error", n, err)
+	}
+	items := listOutbox(t, task)
+	if items[0].PublishedAt == nil || items[1].PublishedAt != nil || items[2].PublishedAt != nil {
+		t.Fatal("only the first message should be published")
+	}
+	if items[1].Attempts != 1 || items[1].LastError == nil || items[2].Attempts != 0 {
+		t.Fatalf("attempts = %d/%d, want 1/0", items[1].Attempts, items[2].Attempts)
+	}
+
+	// 下一轮从失败的消息开始，整体顺序与写入顺序一致
+	sink.failAt = -1
+	if n, err := task.publishOutbox(); err != nil || n != 2 {
+		t.Fatalf("publishOutbox() = %d, %v, want 2", n, err)
+	}
+	if n, err := task.publishOutbox(); err != nil || n != 0 {
+		t.Fatalf("publishOutbox() with nothing pending = %d, %v, want 0", n, err)
+	}
+	if len(sink.published) != 3 {
+		t.Fatalf("published %d messages, want 3", len(sink.published))
+	}
+	for i, m := range sink.published {
+		if m.ID != items[i].ID || m.Contract != testContract || m.Kind != eventoutbox.KindEvent {
+			t.Fatalf("message %d = %d/%s/%s, want id %d", i, m.ID, m.Contract, m.Kind, items[i].ID)
+		}
+	}
+}
+
+func TestOutboxRetractOnReorg(t *testing.T) {
+	ctx := context.Background()
+	task, sink := newOutboxTestTask(t)
+	h10, h11, h12 := testHeader(10), testHeader(11), testHeader(12)
+	logs := []ethereumTypes.Log{
+		testDepositLog(t, task, h10, "0x01", 0, 100),
+		testDepositLog(t, task, h11, "0x02", 0, 200),
+		testDepositLog(t, task, h11, "0x02", 1, 300),
+		testDepositLog(t, task, h12, "0x03", 0, 400),
+	}
+	if err := task.applyRange(ctx, logs, []*ethereumTypes.Header{h10, h11, h12}, 12, noCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := task.publishOutbox(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 回滚到区块 10：区块 11、12 的事件按与提交相反的顺序撤回
+	if err := task.rollbackTo(ctx, 10); err != nil {
+		t.Fatal(err)
+	}
+	if events, err := contractevents.ListAfterBlock(ctx, task.DB, task.ChainID, task.Address, 10); err != nil || len(events) != 0 {
+		t.Fatalf("contract_events after ancestor = %d, %v, want 0", len(events), err)
+	}
+	if _, err := task.publishOutbox(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		kind     string
+		block    uint64
+		logIndex int32
+	}{
+		{eventoutbox.KindEvent, 10, 0},
+		{eventoutbox.KindEvent, 11, 0},
+		{eventoutbox.KindEvent, 11, 1},
+		{eventoutbox.KindEvent, 12, 0},
+		{eventoutbox.KindRetract, 12, 0},
+		{eventoutbox.KindRetract, 11, 1},
+		{eventoutbox.KindRetract, 11, 0},
+	}
+	if len(sink.published) != len(want) {
+		t.Fatalf("published %d messages, want %d", len(sink.published), len(want))
+	}
+	for i, w := range want {
+		m := sink.published[i]
+		var payload outbox.Payload
+		if err := json.Unmarshal(m.Payload, &payload); err != nil {
+			t.Fatal(err)
+		}
+		if m.Kind != w.kind || m.Block != w.block || payload.LogIndex != w.logIndex {
+			t.Fatalf("message %d = %s/%d/%d, want %s/%d/%d", i, m.Kind, m.Block, payload.LogIndex, w.kind, w.block, w.logIndex)
+		}
+		if i > 0 && m.ID <= sink.published[i-1].ID {
+			t.Fatalf("message %d id %d is not after %d", i, m.ID, sink.published[i-1].ID)
+		}
+	}
+}
+
+func TestCleanupOutbox(t *testing.T) {
+	ctx := context.Background()
+	task, _ := newOutboxTestTask(t)
+	now := time.Now()
+	old, recent := now.Add(-defaultOutboxRetention-time.Hour), now.Add(-time.Hour)
+
+	items := []*model.EventOutbox{
+		{ContractAddress: testContract, PublishedAt: &old},    // 超过保留时间，删除
+		{ContractAddress: testContract, PublishedAt: &recent}, // 保留时间内
+		{ContractAddress: testContract},                       // 未发布的消息不论多旧都保留
+		{ContractAddress: "0x2222222222222222222222222222222222222222", PublishedAt: &old},
+	}
+	for _, item := range items {
+		item.ChainID, item.Kind, item.EventName, item.Payload = task.ChainID, eventoutbox.KindEvent, "Deposit", "{}"
+	}
+	if err := eventoutbox.CreateBatch(ctx, task.DB, items); err != nil {
+		t.Fatal(err)
+	}
+
+	task.cleanupOutbox()
+	left := listOutbox(t, task)
+	if len(left) != 3 {
+		t.Fatalf("event_outbox has %d rows after cleanup, want 3", len(left))
+	}
+	for _, item := range left {
+		if item.ID == items[0].ID {
+			t.Fatal("expired published message was not deleted")
+		}
+	}
+}
+
+// preparingHandler 记录 PrepareRange 调用次数的事件处理器
+type preparingHandler struct {
+	noopHandler
+	calls int
+	err   error
+}
+
+func (h *preparingHandler) PrepareRange(context.Context) error {
+	h.calls++
+	return h.err
+}
+
+func TestApplyRangePrepare(t *testing.T) {
+	ctx := context.Background()
+	task, _ := newOutboxTestTask(t)
+	h := &preparingHandler{err: errors.New("read state failed")}
+	task.handler = h
+	h10 := testHeader(10)
+	logs := []ethereumTypes.Log{testDepositLog(t, task, h10, "0x01", 0, 100)}
+
+	// 初始化失败时整个区间回滚
+	if err := task.applyRange(ctx, logs, []*ethereumTypes.Header{h10}, 10, noCheckpoint); !errors.Is(err, h.err) {
+		t.Fatalf("applyRange() error = %v, want %v", err, h.err)
+	}
+	if items := listOutbox(t, task); len(items) != 0 {
+		t.Fatalf("event_outbox has %d rows after rollback, want 0", len(items))
+	}
+
+	// 没有日志的区间同样调用
+	h.err = nil
+	if err := task.applyRange(ctx, nil, []*ethereumTypes.Header{testHeader(11)}, 11, noCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+	if h.calls != 2 {
+		t.Fatalf("PrepareRange called %d times, want 2", h.calls)
+	}
+}
+
+// resettingHandler 记录 Reset 调用次数的事件处理器
+type resettingHandler struct {
+	noopHandler
+	resets int
+}
+
+func (h *resettingHandler) Reset(context.Context, *gorm.DB) error {
+	h.resets++
+	return nil
+}
+
+func TestResetTo(t *testing.T) {
+	ctx := context.Background()
+	task, _ := newOutboxTestTask(t)
+	h := &resettingHandler{}
+	task.handler = h
+	h10, h11 := testHeader(10), testHeader(11)
+	logs := []ethereumTypes.Log{
+		testDepositLog(t, task, h10, "0x01", 0, 100),
+		testDepositLog(t, task, h11, "0x02", 0, 200),
+	}
+	if err := task.applyRange(ctx, logs, []*ethereumTypes.Header{h10, h11}, 11, noCheckpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	// 全量重建清空派生表，删除部署区块之后的全部事件，同步进度重置到部署区块
+	if err := task.resetTo(ctx, 5); err != nil {
+		t.Fatal(err)
+	}
+	if h.resets != 1 {
+		t.Fatalf("Reset called %d times, want 1", h.resets)
+	}
+	if events, err := contractevents.ListAfterBlock(ctx, task.DB, task.ChainID, task.Address, 5); err != nil || len(events) != 0 {
+		t.Fatalf("contract_events after deployment block = %d, %v, want 0", len(events), err)
+	}
+	if last, err := task.getCheckpoint(ctx); err != nil || last != 5 {
+		t.Fatalf("checkpoint = %d, %v, want 5", last, err)
+	}
+}
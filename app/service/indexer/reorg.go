@@ -0,0 +1,237 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/failedevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/reorgsnapshots"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncblocks"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncstatus"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/webhookdeliveries"
+	"github.com/ethereum/go-ethereum"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// MaxReorgDepth 保留区块哈希和回滚快照的区块数，超过该深度的重组无法自动回滚，更早的区块视为不可逆
+const MaxReorgDepth = 128
+
+// errReorgTooDeep 在已保存的区块哈希中找不到公共祖先
+var errReorgTooDeep = errors.New("reorg deeper than stored block window")
+
+// fetchHeaders 按区块号获取区块头，返回顺序与 numbers 一致
+func (t *Task) fetchHeaders(ctx context.Context, numbers []uint64) ([]*ethereumTypes.Header, error) {
+	headers := make([]*ethereumTypes.Header, 0, len(numbers))
+	for _, n := range numbers {
+		header, err := t.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(n))
+		if err != nil {
+			return nil, fmt.Errorf("fetchHeaders: get header %d error: %w", n, err)
+		}
+		headers = append(headers, header)
+	}
+	return headers, nil
+}
+
+// saveBlockHashes 保存已处理区块的哈希，并清理超出重组窗口的区块哈希和快照
+func (t *Task) saveBlockHashes(ctx context.Context, headers []*ethereumTypes.Header) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	items := make([]*model.SyncBlock, 0, len(headers))
+	for _, h := range headers {
+		items = append(items, &model.SyncBlock{
+			ChainID:         t.ChainID,
+			ContractAddress: t.Address,
+			BlockNumber:     h.Number.Uint64(),
+			BlockHash:       h.Hash().Hex(),
+			ParentHash:      h.ParentHash.Hex(),
+		})
+	}
+	if err := syncblocks.CreateBatch(ctx, t.DB, items); err != nil {
+		return fmt.Errorf("saveBlockHashes: create sync_blocks error: %w", err)
+	}
+
+	last := headers[len(headers)-1].Number.Uint64()
+	if last <= MaxReorgDepth {
+		return nil
+	}
+	pruneBefore := last - MaxReorgDepth
+	if err := syncblocks.DeleteBeforeBlock(ctx, t.DB, t.ChainID, t.Address, pruneBefore); err != nil {
+		return fmt.Errorf("saveBlockHashes: prune sync_blocks error: %w", err)
+	}
+	if err := reorgsnapshots.DeleteBeforeBlock(ctx, t.DB, t.ChainID, t.Address, pruneBefore); err != nil {
+		return fmt.Errorf("saveBlockHashes: prune reorg_snapshots error: %w", err)
+	}
+	return nil
+}
+
+// detectReorg 比较已同步的最后一个区块哈希与链上当前哈希，不一致时回溯公共祖先。
+// 返回公共祖先区块号和是否发生了重组
+func (t *Task) detectReorg(ctx context.Context, lastHeight uint64) (uint64, bool, error) {
+	stored, err := syncblocks.GetByBlockNumber(ctx, t.DB, t.ChainID, t.Address, lastHeight)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// 启用重组检测之前同步的区块没有哈希记录，无法比较
+			return 0, false, nil
+		}
+		return 0, false, fmt.Errorf("detectReorg: get sync_blocks error: %w", err)
+	}
+
+	matched, err := t.isCanonical(ctx, stored)
+	if err != nil {
+		return 0, false, err
+	}
+	if matched {
+		return 0, false, nil
+	}
+
+	// 从最后同步的区块往前回溯，找到第一个哈希仍在主链上的区块
+	blocks, err := syncblocks.ListBeforeBlockDesc(ctx, t.DB, t.ChainID, t.Address, lastHeight-1, MaxReorgDepth)
+	if err != nil {
+		return 0, false, fmt.Errorf("detectReorg: list sync_blocks error: %w", err)
+	}
+	for _, b := range blocks {
+		matched, err := t.isCanonical(ctx, b)
+		if err != nil {
+			return 0, false, err
+		}
+		if matched {
+			return b.BlockNumber, true, nil
+		}
+	}
+	return 0, false, fmt.Errorf("detectReorg: last synced block %d: %w", lastHeight, errReorgTooDeep)
+}
+
+// isCanonical 判断已保存的区块哈希是否仍在主链上
+func (t *Task) isCanonical(ctx context.Context, b *model.SyncBlock) (bool, error) {
+	header, err := t.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(b.BlockNumber))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			// 重组后主链变短，该高度暂时不存在
+			return false, nil
+		}
+		return false, fmt.Errorf("isCanonical: get header %d error: %w", b.BlockNumber, err)
+	}
+	return header.Hash().Hex() == b.BlockHash, nil
+}
+
+// SaveSnapshot 在当前事务（t.DB）中保存派生表行的快照，同一区块、表和范围只保存第一次（即区块处理前）的内容。
+// scope 为空表示合约整体，否则为快照覆盖的范围（如用户地址）
+func (t *Task) SaveSnapshot(ctx context.Context, blockNumber uint64, table, scope string, rows interface{}) error {
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("SaveSnapshot: marshal %s error: %w", table, err)
+	}
+	if err := reorgsnapshots.CreateIfNotExists(ctx, t.DB, &model.ReorgSnapshot{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		BlockNumber:     blockNumber,
+		TargetTable:     table,
+		Scope:           scope,
+		SnapshotData:    string(data),
+	}); err != nil {
+		return fmt.Errorf("SaveSnapshot: create reorg_snapshots error: %w", err)
+	}
+	return nil
+}
+
+// rollbackTo 撤销公共祖先之后所有区块派生的数据：删除事件和区块哈希，派生表恢复到第一个孤块处理前的快照
+func (t *Task) rollbackTo(ctx context.Context, ancestor uint64) error {
+	return t.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := t.checkLease(ctx, tx); err != nil {
+			return err
+		}
+		snapshots, err := reorgsnapshots.ListAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor)
+		if err != nil {
+			return fmt.Errorf("rollbackTo: list reorg_snapshots error: %w", err)
+		}
+
+		// 同一(表, 范围)只使用最早的快照，即公共祖先处的状态
+		restored := make(map[string]bool)
+		for _, s := range snapshots {
+			key := s.TargetTable + ":" + s.Scope
+			if restored[key] {
+				continue
+			}
+			restored[key] = true
+			if err := t.handler.Restore(ctx, tx, s); err != nil {
+				return fmt.Errorf("rollbackTo: restore %s (scope=%s, block=%d) error: %w", s.TargetTable, s.Scope, s.BlockNumber, err)
+			}
+		}
+		if err := t.discardAfter(ctx, tx, ancestor); err != nil {
+			return fmt.Errorf("rollbackTo: %w", err)
+		}
+		return nil
+	})
+}
+
+// resetTo 全量重建前清空合约的派生表，并删除 ancestor 之后的事件和区块哈希、把同步进度重置到 ancestor，
+// ancestor 为部署区块，之后从第一个区块重新处理
+func (t *Task) resetTo(ctx context.Context, ancestor uint64) error {
+	return t.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := t.checkLease(ctx, tx); err != nil {
+			return err
+		}
+		if err := t.handler.Reset(ctx, tx); err != nil {
+			return fmt.Errorf("resetTo: reset %s derived tables error: %w", t.Module.Name(), err)
+		}
+		if err := t.discardAfter(ctx, tx, ancestor); err != nil {
+			return fmt.Errorf("resetTo: %w", err)
+		}
+		return nil
+	})
+}
+
+// discardAfter 在事务 tx 中删除 ancestor 之后写入的事件、死信、未投递的 webhook、区块哈希和快照，并把同步进度重置到 ancestor
+func (t *Task) discardAfter(ctx context.Context, tx *gorm.DB, ancestor uint64) error {
+	if err := t.handler.Rollback(ctx, tx, ancestor); err != nil {
+		return fmt.Errorf("rollback %s events error: %w", t.Module.Name(), err)
+	}
+	if err := t.saveOutboxRetractions(ctx, tx, ancestor); err != nil {
+		return err
+	}
+	if err := contractevents.DeleteAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor); err != nil {
+		return fmt.Errorf("delete contract_events error: %w", err)
+	}
+	if err := failedevents.DeleteAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor); err != nil {
+		return fmt.Errorf("delete failed_events error: %w", err)
+	}
+	// 已投递的 webhook 无法撤回，只删除尚未投递的记录
+	if err := webhookdeliveries.DeletePendingAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor); err != nil {
+		return fmt.Errorf("delete webhook_deliveries error: %w", err)
+	}
+	if err := syncblocks.DeleteAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor); err != nil {
+		return fmt.Errorf("delete sync_blocks error: %w", err)
+	}
+	if err := reorgsnapshots.DeleteAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor); err != nil {
+		return fmt.Errorf("delete reorg_snapshots error: %w", err)
+	}
+	// 同步进度与回滚在同一事务中重置到 ancestor
+	if err := syncstatus.SaveCheckpoint(ctx, tx, t.Address, t.ChainID, ancestor, true); err != nil {
+		return fmt.Errorf("reset sync_status error: %w", err)
+	}
+	return nil
+}
+
+// handleReorg 检测到重组时回滚数据并把同步进度重置到公共祖先，返回是否发生了重组
+func (t *Task) handleReorg(ctx context.Context, lastHeight uint64) (bool, error) {
+	ancestor, reorged, err := t.detectReorg(ctx, lastHeight)
+	if err != nil || !reorged {
+		return false, err
+	}
+
+	logx.Errorf("chain reorg detected, contract=%s, last synced=%d, common ancestor=%d", t.Address, lastHeight, ancestor)
+	if err := t.rollbackTo(ctx, ancestor); err != nil {
+		return false, err
+	}
+	t.cacheCheckpoint(ctx, ancestor)
+	t.notifyEvents(ctx, ancestor, true)
+	return true, nil
+}
@@ -0,0 +1,97 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	subscribeMinBackoff = 1 * time.Second  // 订阅断开后的首次重连等待时间
+	subscribeMaxBackoff = 30 * time.Second // 重连等待时间上限
+)
+
+// notify 唤醒同步循环，已有待处理的唤醒时直接忽略
+func (t *Task) notify() {
+	select {
+	case t.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// subscribe 订阅新区块和合约日志，收到通知后立即唤醒同步循环。
+// 日志仍由 queryLogs 按同步进度用 FilterLogs 拉取，保证顺序、整区间提交和重组检测不变；
+// 订阅出错时按指数退避重连，期间由轮询兜底，重连后从同步进度开始补齐缺口
+func (t *Task) subscribe() {
+	backoff := subscribeMinBackoff
+	for {
+		started := time.Now()
+		err := t.subscribeOnce(t.Context)
+		if t.Context.Err() != nil {
+			logx.Info(fmt.Sprintf("subscription %s stopped", t.Name()))
+			return
+		}
+		logx.Error(fmt.Sprintf("subscription %s closed, fallback to polling, retry in %s: %v", t.Name(), backoff, err))
+		// 立即触发一次轮询补齐断开期间的区块
+		t.notify()
+
+		// 订阅保持了一段时间后再断开时重置退避时间
+		if time.Since(started) > subscribeMaxBackoff {
+			backoff = subscribeMinBackoff
+		}
+		select {
+		case <-t.Context.Done():
+			logx.Info(fmt.Sprintf("subscription %s stopped", t.Name()))
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, subscribeMaxBackoff)
+	}
+}
+
+func (t *Task) subscribeOnce(ctx context.Context) error {
+	heads := make(chan *ethereumTypes.Header, 16)
+	headSub, err := t.Client.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return fmt.Errorf("subscribe new head error: %w", err)
+	}
+	defer headSub.Unsubscribe()
+
+	logs := make(chan ethereumTypes.Log, 128)
+	logSub, err := t.Client.SubscribeFilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []ethCommon.Address{ethCommon.HexToAddress(t.Address)},
+	}, logs)
+	if err != nil {
+		return fmt.Errorf("subscribe filter logs error: %w", err)
+	}
+	defer logSub.Unsubscribe()
+
+	logx.Info(fmt.Sprintf("subscription %s started", t.Name()))
+	// 订阅建立后补齐建立之前的区块
+	t.notify()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-headSub.Err():
+			return errors.Join(errors.New("new head subscription error"), err)
+		case err := <-logSub.Err():
+			return errors.Join(errors.New("filter logs subscription error"), err)
+		case <-heads:
+			t.notify()
+		case l := <-logs:
+			if l.Removed {
+				// 已推送的日志被重组移除，由 queryLogs 的重组检测回滚
+				logx.Info(fmt.Sprintf("subscription %s: log removed by reorg, Block: %d, TxHash: %s", t.Name(), l.BlockNumber, l.TxHash.Hex()))
+			}
+			t.notify()
+		}
+	}
+}
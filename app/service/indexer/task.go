@@ -0,0 +1,525 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractevents"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/common"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/outbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/rpcpool"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/webhook"
+	"github.com/go-redis/redis/v8"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// Task 一个合约的同步任务：按同步进度拉取合约日志，交给合约类型对应模块的事件处理器处理
+type Task struct {
+	Context       context.Context
+	Config        *config.Config
+	DB            *gorm.DB
+	RedisClient   *redis.Client
+	ChainID       int32
+	ContractName  int32
+	ABIStr        string
+	Address       string
+	CreatedHash   *string
+	SyncMode      string
+	Confirmations uint64
+	Client        *rpcpool.Pool
+	ABI           *abi.ABI
+	Module        Module
+	Sink          outbox.Sink     // 为 nil 时不写事件发件箱
+	Webhooks      *webhook.Sender // 为 nil 时不投递 webhook
+
+	handler           ContractHandler                  // 模块为本任务创建的事件处理器
+	eventHandlers     map[string]EventHandler          // 按事件签名（topic0）索引的事件处理函数
+	blockRange        uint64                           // 当前 eth_getLogs 查询区间大小，按结果数量和节点错误自适应调整
+	rateLimitAttempts int32                            // 连续被节点限流的次数
+	pausedUntil       time.Time                        // 被限流或事件处理失败后暂停同步到该时间
+	failingLog        string                           // 连续处理失败的日志（tx:logIndex）
+	failingAttempts   int32                            // failingLog 连续处理失败的次数
+	isHalted          bool                             // 是否因待处理的死信暂停同步
+	headerCache       map[uint64]*ethereumTypes.Header // 当前区间已获取的区块头，供 handler 读取区块时间戳
+	wakeup            chan struct{}                    // 订阅到新区块或合约日志时唤醒同步循环
+	outboxWakeup      chan struct{}                    // 事件提交或回滚后唤醒发件箱 relay
+	webhookWakeup     chan struct{}                    // 事件提交后唤醒 webhook 投递
+	webhookSubs       *webhookSubscriptions            // 已启用订阅的缓存，任务副本之间共享
+	lease             *syncLease                       // 合约同步租约，任务副本之间共享
+}
+
+// NewTask 为 chain_contracts 中的一个合约创建同步任务，事件处理器由 contract_name 对应的已注册模块提供
+func NewTask(serviceCtx *common.ServiceContext, contract *common.ContractInfo) (*Task, error) {
+	module, ok := Lookup(contract.ContractName)
+	if !ok {
+		return nil, fmt.Errorf("NewTask: no module registered for contract name %d, contract: %s", contract.ContractName, contract.Address)
+	}
+
+	abiStr := contract.ABIStr
+	if abiStr == "" {
+		abiStr = module.ABI()
+	}
+	ABI, err := common.GetABI(abiStr)
+	if err != nil {
+		return nil, fmt.Errorf("NewTask: parse ABI of %s error: %w", contract.Address, err)
+	}
+
+	t := &Task{
+		Context:       serviceCtx.Context,
+		Config:        serviceCtx.Config,
+		DB:            serviceCtx.DB,
+		RedisClient:   serviceCtx.RedisClient,
+		ChainID:       contract.ChainID,
+		ContractName:  contract.ContractName,
+		ABIStr:        abiStr,
+		Address:       contract.Address,
+		CreatedHash:   contract.CreatedHash,
+		SyncMode:      contract.SyncMode,
+		Confirmations: contract.Confirmations,
+		Client:        contract.Client,
+		ABI:           ABI,
+		Module:        module,
+		Sink:          serviceCtx.Sink,
+		Webhooks:      serviceCtx.Webhooks,
+		wakeup:        make(chan struct{}, 1),
+		outboxWakeup:  make(chan struct{}, 1),
+		webhookWakeup: make(chan struct{}, 1),
+		webhookSubs:   &webhookSubscriptions{},
+		lease:         newSyncLease("daemon"),
+	}
+	if t.handler, t.eventHandlers, err = t.newHandler(); err != nil {
+		return nil, fmt.Errorf("NewTask: %w", err)
+	}
+	return t, nil
+}
+
+// newHandler 由模块为当前任务创建事件处理器，并按 ABI 把事件名称映射为事件签名（topic0）
+func (t *Task) newHandler() (ContractHandler, map[string]EventHandler, error) {
+	handler, err := t.Module.NewHandler(t)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create %s handler error: %w", t.Module.Name(), err)
+	}
+	eventHandlers := make(map[string]EventHandler)
+	for name, h := range handler.EventHandlers() {
+		ev, ok := t.ABI.Events[name]
+		if !ok {
+			return nil, nil, fmt.Errorf("event %s of %s handler not found in ABI", name, t.Module.Name())
+		}
+		eventHandlers[ev.ID.Hex()] = h
+	}
+	return handler, eventHandlers, nil
+}
+
+// Name 任务名称，用于日志和任务监管
+func (t *Task) Name() string {
+	return fmt.Sprintf("%s-%d-%s", t.Module.Name(), t.ChainID, t.Address)
+}
+
+func (t *Task) Start() {
+	// 租约续期使用任务副本，避免与同步循环中切换为事务的 t.DB 互相干扰；写入前由同步循环在事务内校验租约
+	holder := *t
+	common.Supervise(t.Context, t.Name()+"-lease", holder.holdLease)
+	common.Supervise(t.Context, t.Name(), t.process)
+	if t.Client.SupportsSubscription() {
+		common.Supervise(t.Context, t.Name()+"-subscribe", t.subscribe)
+	}
+	if t.Sink != nil {
+		// 与租约续期相同，relay 使用任务副本
+		relay := *t
+		common.Supervise(t.Context, t.Name()+"-outbox", relay.relayOutbox)
+	}
+	if t.Webhooks != nil {
+		deliverer := *t
+		common.Supervise(t.Context, t.Name()+"-webhook", deliverer.deliverWebhooks)
+	}
+}
+
+func (t *Task) process() {
+	for {
+		select {
+		case <-t.Context.Done():
+			logx.Info(fmt.Sprintf("task %s stopped", t.Name()))
+			return
+		default:
+		}
+
+		// 追块期间连续拉取，追上目标高度或出错后再等待下一轮
+		if t.queryLogs() {
+			continue
+		}
+		// 等待订阅唤醒；订阅不可用时按轮询间隔兜底
+		wakeup, delay := t.wakeup, t.pollInterval()
+		if wait := time.Until(t.pausedUntil); wait > 0 {
+			// 被限流、事件处理失败或同步暂停时忽略订阅唤醒，等待结束后再同步
+			wakeup, delay = nil, wait
+		}
+		select {
+		case <-t.Context.Done():
+			logx.Info(fmt.Sprintf("task %s stopped", t.Name()))
+			return
+		case <-wakeup:
+		case <-time.After(delay):
+		}
+	}
+}
+
+// queryLogs 同步一个区块区间，返回是否需要立即继续同步（尚未追上目标高度或区间被缩小后重试）
+func (t *Task) queryLogs() bool {
+	// 租约由其他进程（如 backfill）持有时暂停同步，由 holdLease 取得租约后恢复
+	if !t.lease.held.Load() {
+		return false
+	}
+
+	startBlock := big.NewInt(0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	// 存在待处理的死信时暂停同步，等待运维 dlq retry / dlq drop
+	halted, err := t.halted(ctx)
+	if err != nil {
+		logx.Error("queryLogs: ", err)
+		return false
+	}
+	if halted {
+		t.pausedUntil = time.Now().Add(haltedPollInterval)
+		return false
+	}
+
+	lastHeigh, err := t.getCheckpoint(ctx)
+	if err != nil {
+		logx.Error("queryLogs: ", err)
+		return false
+	}
+
+	if lastHeigh == 0 {
+		first, err := t.firstBlock(ctx)
+		if err != nil {
+			logx.Info(err)
+			return false
+		}
+		startBlock = new(big.Int).SetUint64(first)
+	} else {
+		// 检查已同步区块是否被重组，重组时回滚到公共祖先，下一轮从公共祖先之后重新同步
+		reorged, err := t.handleReorg(ctx, lastHeigh)
+		if err != nil {
+			logx.Error("queryLogs: handle reorg error: ", err)
+			return false
+		}
+		if reorged {
+			return true
+		}
+		startBlock = big.NewInt(int64(lastHeigh + 1))
+	}
+
+	// 按合约配置的同步模式确定可同步的最高区块
+	currentHeight, err := t.getSyncHeight(ctx)
+	if err != nil {
+		logx.Info(err)
+		return false
+	}
+
+	if big.NewInt(int64(currentHeight)).Cmp(startBlock) < 0 {
+		return false
+	}
+
+	blockRange := t.currentBlockRange()
+	endBlock := big.NewInt(0).Add(startBlock, new(big.Int).SetUint64(blockRange-1))
+	if endBlock.Cmp(big.NewInt(int64(currentHeight))) > 0 {
+		endBlock = big.NewInt(int64(currentHeight))
+	}
+
+	logx.Info(fmt.Sprintf("sync %s, start: %d, end: %d, current: %d, range: %d", t.Name(), startBlock.Int64(), endBlock.Int64(), currentHeight, blockRange))
+
+	logs, err := t.Client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: startBlock,
+		ToBlock:   endBlock,
+		Addresses: []ethCommon.Address{ethCommon.HexToAddress(t.Address)},
+	})
+
+	if err != nil {
+		if isRangeTooLargeError(err) && blockRange > 1 {
+			// 节点拒绝过大的查询区间，缩小区间后立即重试
+			t.shrinkBlockRange()
+			logx.Info(fmt.Sprintf("queryLogs: shrink block range to %d, err: %v", t.blockRange, err))
+			return true
+		}
+		if isRateLimitError(err) {
+			// 被限流时不缩小区间，按指数退避后重试同一区间
+			logx.Info(fmt.Sprintf("queryLogs: rate limited, back off %s, err: %v", t.backoffRateLimit(), err))
+			return false
+		}
+		logx.Info(err)
+		t.recordSyncError(err)
+		return false
+	}
+	t.resetRateLimit()
+	t.growBlockRange(len(logs))
+
+	// 获取有日志的区块和区间末尾区块的区块头：用于保存区块哈希、校验日志来自同一条链，并缓存区块时间戳。
+	// 没有日志的区块不产生派生数据，重组回滚只需要这些区块的哈希即可找到公共祖先
+	headers, err := t.fetchHeaders(ctx, headerBlockNumbers(logs, endBlock.Uint64()))
+	if err != nil {
+		logx.Info(err)
+		return false
+	}
+
+	// 本轮同步后仍未追上目标高度时标记为正在同步
+	isSyncing := endBlock.Uint64() < currentHeight
+
+	// 整个区间在同一事务中提交：快照、全部日志、区块哈希与同步进度要么一起生效，要么一起回滚。
+	// 任一日志处理失败时不推进同步进度，退避后重新处理该区间（handler 按 (tx, logIndex) 幂等），
+	// 同一日志连续失败 handleMaxAttempts 次后写入死信队列并暂停同步
+	errTx := t.applyRange(ctx, logs, headers, currentHeight, func(ctx context.Context) error {
+		return t.saveCheckpoint(ctx, endBlock.Uint64(), isSyncing)
+	})
+	if errTx != nil {
+		if errors.Is(errTx, errLogBlockHashMismatch) {
+			// 查询期间发生了重组，等待下一轮
+			logx.Info("queryLogs: ", errTx)
+			return false
+		}
+		var he *handlerError
+		if errors.As(errTx, &he) {
+			t.onHandlerError(ctx, he)
+		} else {
+			logx.Error("queryLogs: transaction rollback due to error, range will be retried: ", errTx)
+		}
+		t.recordSyncError(errTx)
+		return false
+	}
+
+	t.cacheCheckpoint(ctx, endBlock.Uint64())
+	if len(logs) > 0 {
+		t.notifyEvents(ctx, endBlock.Uint64(), false)
+	}
+	return isSyncing
+}
+
+// DeployBlock 合约部署交易所在的区块
+func (t *Task) DeployBlock(ctx context.Context) (uint64, error) {
+	receipt, err := t.Client.TransactionReceipt(ctx, ethCommon.HexToHash(*t.CreatedHash))
+	if err != nil {
+		return 0, fmt.Errorf("DeployBlock: get receipt of creation tx error: %w", err)
+	}
+	return receipt.BlockNumber.Uint64(), nil
+}
+
+// firstBlock 首次同步的起始区块：合约部署交易所在区块的下一个区块
+func (t *Task) firstBlock(ctx context.Context) (uint64, error) {
+	deployBlock, err := t.DeployBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return deployBlock + 1, nil
+}
+
+// errLogBlockHashMismatch 日志与区块头不在同一条链上，说明查询期间发生了重组
+var errLogBlockHashMismatch = errors.New("log block hash mismatch")
+
+// headerBlockNumbers 返回需要获取区块头的区块号：有日志的区块和区间末尾区块，按升序排列
+func headerBlockNumbers(logs []ethereumTypes.Log, endBlock uint64) []uint64 {
+	numbers := make([]uint64, 0, len(logs)+1)
+	for _, l := range logs {
+		if len(numbers) == 0 || numbers[len(numbers)-1] != l.BlockNumber {
+			numbers = append(numbers, l.BlockNumber)
+		}
+	}
+	if len(numbers) == 0 || numbers[len(numbers)-1] != endBlock {
+		numbers = append(numbers, endBlock)
+	}
+	return numbers
+}
+
+// applyRange 在同一事务中按 (block, logIndex) 顺序处理区间内的日志，保存区块哈希，并调用 saveCheckpoint 推进同步进度。
+// headers 需包含所有有日志的区块，currentHeight 用于判断区块是否还在重组窗口内
+func (t *Task) applyRange(ctx context.Context, logs []ethereumTypes.Log, headers []*ethereumTypes.Header, currentHeight uint64, saveCheckpoint func(ctx context.Context) error) error {
+	t.headerCache = make(map[uint64]*ethereumTypes.Header, len(headers))
+	for _, h := range headers {
+		t.headerCache[h.Number.Uint64()] = h
+	}
+	defer func() { t.headerCache = nil }()
+
+	blockLogs := make(map[uint64][]ethereumTypes.Log)
+	for _, l := range logs {
+		header, ok := t.headerCache[l.BlockNumber]
+		if !ok || l.BlockHash != header.Hash() {
+			return fmt.Errorf("%w, Block: %d, TxHash: %s", errLogBlockHashMismatch, l.BlockNumber, l.TxHash.Hex())
+		}
+		blockLogs[l.BlockNumber] = append(blockLogs[l.BlockNumber], l)
+	}
+
+	return t.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 在事务内使用 tx，确保所有写入共享同一事务上下文
+		originalDB := t.DB
+		t.DB = tx
+		defer func() { t.DB = originalDB }()
+
+		if err := t.checkLease(ctx, tx); err != nil {
+			return err
+		}
+		if p, ok := t.handler.(RangePreparer); ok {
+			if err := p.PrepareRange(ctx); err != nil {
+				return fmt.Errorf("prepare range error: %w", err)
+			}
+		}
+
+		var snapshotBlock uint64
+		for _, l := range logs {
+			// 处理区块的第一条日志前保存派生表快照，供链重组时回滚；超出重组窗口的区块不会再被重组，无需快照
+			if l.BlockNumber != snapshotBlock && l.BlockNumber+MaxReorgDepth >= currentHeight {
+				if err := t.handler.Snapshot(ctx, l.BlockNumber, blockLogs[l.BlockNumber]); err != nil {
+					return err
+				}
+				snapshotBlock = l.BlockNumber
+			}
+
+			if err := t.handleLog(ctx, l); err != nil {
+				// 出错直接返回错误，触发整个区间回滚
+				return err
+			}
+		}
+
+		if f, ok := t.handler.(RangeFinisher); ok && len(headers) > 0 {
+			if err := f.FinishRange(ctx, logs, headers[len(headers)-1].Number.Uint64()); err != nil {
+				return fmt.Errorf("finish range error: %w", err)
+			}
+		}
+
+		if err := t.saveBlockHashes(ctx, headers); err != nil {
+			return err
+		}
+		return saveCheckpoint(ctx)
+	})
+}
+
+// handleLog 在当前事务（t.DB）中保存并处理单条日志，已处理过的日志直接跳过。
+// handler 在 savepoint 中执行，失败时返回 handlerError 使整个区间回滚重试；
+// 运维已通过 dlq drop 丢弃的日志只回滚该 handler 的写入并跳过
+func (t *Task) handleLog(ctx context.Context, l ethereumTypes.Log) error {
+	// 判断日志是否已处理（同一交易可能包含多条日志，如 UpdatePool + Deposit）
+	exists, err := t.HasProcessedLog(ctx, l.TxHash.Hex(), l.Index)
+	if err != nil {
+		return err
+	}
+	if exists {
+		logx.Info(fmt.Sprintf("queryLogs: log already processed, TxHash=%s, LogIndex=%d", l.TxHash.Hex(), l.Index))
+		return nil
+	}
+
+	// 保存统一事件记录（与后续事件处理共享同一事务）
+	if err := t.SaveContractEvent(ctx, l); err != nil {
+		return err
+	}
+
+	eventID := l.Topics[0].Hex()
+	h, ok := t.eventHandlers[eventID]
+	if !ok {
+		logx.Info(fmt.Sprintf("Unknown event ID: %s, Block: %d, TxHash: %s", eventID, l.BlockNumber, l.TxHash.Hex()))
+		return nil
+	}
+
+	errHandle := t.DB.WithContext(ctx).Transaction(func(sp *gorm.DB) error {
+		originalDB := t.DB
+		t.DB = sp
+		defer func() { t.DB = originalDB }()
+		return h.Handle(l)
+	})
+	if errHandle != nil {
+		dropped, err := t.isDropped(ctx, l)
+		if err != nil {
+			return err
+		}
+		if dropped {
+			logx.Info(fmt.Sprintf("queryLogs: skip dropped event: %s, Block: %d, TxHash: %s, Err: %v", eventID, l.BlockNumber, l.TxHash.Hex(), errHandle))
+			return nil
+		}
+		return &handlerError{Log: l, HandlerName: h.Name, Err: errHandle}
+	}
+	return nil
+}
+
+// eventName 根据事件签名（topic0）查找事件名称
+func (t *Task) eventName(eventID string) string {
+	for name, ev := range t.ABI.Events {
+		if ev.ID.Hex() == eventID {
+			return name
+		}
+	}
+	return ""
+}
+
+func (t *Task) HasProcessedTx(ctx context.Context, txHash string) (bool, error) {
+	return contractevents.ExistsByTxHash(ctx, t.DB, t.ChainID, txHash)
+}
+
+func (t *Task) HasProcessedLog(ctx context.Context, txHash string, logIndex uint) (bool, error) {
+	return contractevents.ExistsByTxHashAndLogIndex(ctx, t.DB, t.ChainID, txHash, int32(logIndex))
+}
+
+func (t *Task) SaveContractEvent(ctx context.Context, l ethereumTypes.Log) error {
+	// 解析事件名称
+	eventID := l.Topics[0].Hex()
+	eventName := t.eventName(eventID)
+
+	// 获取区块时间戳
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return err
+	}
+
+	// 主题与数据
+	var topic1, topic2, topic3 *string
+	if len(l.Topics) > 1 {
+		s := l.Topics[1].Hex()
+		topic1 = &s
+	}
+	if len(l.Topics) > 2 {
+		s := l.Topics[2].Hex()
+		topic2 = &s
+	}
+	if len(l.Topics) > 3 {
+		s := l.Topics[3].Hex()
+		topic3 = &s
+	}
+	dataHex := fmt.Sprintf("0x%x", l.Data)
+
+	// 构建并保存
+	ev := &model.ContractEvent{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		EventName:       eventName,
+		Topic0:          eventID,
+		Topic1:          topic1,
+		Topic2:          topic2,
+		Topic3:          topic3,
+		Data:            &dataHex,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}
+	if err := contractevents.Create(ctx, t.DB, ev); err != nil {
+		return err
+	}
+	if t.Sink == nil && t.Webhooks == nil {
+		return nil
+	}
+	// 发件箱消息和 webhook 投递记录与事件在同一事务中写入，事件回滚时一并回滚
+	payload := t.eventPayload(ev, l)
+	if err := t.saveOutboxEvent(ctx, ev, payload); err != nil {
+		return err
+	}
+	return t.saveWebhookDeliveries(ctx, ev, payload)
+}
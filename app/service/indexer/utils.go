@@ -0,0 +1,55 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/common"
+)
+
+// GetBlockTimestamp 获取区块时间戳，优先使用当前区间已获取的区块头
+func (t *Task) GetBlockTimestamp(ctx context.Context, blockNumber uint64) (uint64, error) {
+	if header, ok := t.headerCache[blockNumber]; ok {
+		return header.Time, nil
+	}
+	header, err := t.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return 0, err
+	}
+	return header.Time, nil
+}
+
+// getSyncHeight 根据合约的同步模式返回本轮可同步到的最高区块
+func (t *Task) getSyncHeight(ctx context.Context) (uint64, error) {
+	switch t.SyncMode {
+	case "", common.SyncModeLatest:
+		return t.Client.BlockNumber(ctx)
+	case common.SyncModeConfirmations:
+		latest, err := t.Client.BlockNumber(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if latest < t.Confirmations {
+			return 0, nil
+		}
+		return latest - t.Confirmations, nil
+	case common.SyncModeSafe:
+		return t.getTaggedBlockNumber(ctx, rpc.SafeBlockNumber)
+	case common.SyncModeFinalized:
+		return t.getTaggedBlockNumber(ctx, rpc.FinalizedBlockNumber)
+	default:
+		return 0, fmt.Errorf("getSyncHeight: unknown sync mode %q", t.SyncMode)
+	}
+}
+
+// getTaggedBlockNumber 通过 safe / finalized 标签获取区块高度
+func (t *Task) getTaggedBlockNumber(ctx context.Context, tag rpc.BlockNumber) (uint64, error) {
+	header, err := t.Client.HeaderByNumber(ctx, big.NewInt(tag.Int64()))
+	if err != nil {
+		return 0, fmt.Errorf("getTaggedBlockNumber: get %s header error: %w", tag.String(), err)
+	}
+	return header.Number.Uint64(), nil
+}
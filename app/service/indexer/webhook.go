@@ -0,0 +1,207 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/outbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/webhook"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/webhookdeliveries"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/webhooksubscriptions"
+)
+
+const (
+	defaultWebhookPollInterval = 5 * time.Second
+	defaultWebhookMaxAttempts  = 8
+	defaultWebhookWorkers      = 4
+	webhookBaseBackoff         = 10 * time.Second // 首次重试等待时间，之后按指数增长
+	webhookMaxBackoff          = time.Hour
+	webhookBatchSize           = 50
+	webhookCacheTTL            = 30 * time.Second // 订阅变更（CLI 增删、启停）在该时间内生效
+)
+
+// webhookSubscriptions 已启用订阅的缓存，避免每条事件查询一次 webhook_subscriptions
+type webhookSubscriptions struct {
+	mu       sync.Mutex
+	subs     []*model.WebhookSubscription
+	loadedAt time.Time
+}
+
+// enabledWebhooks 返回可能匹配本合约事件的已启用订阅
+func (t *Task) enabledWebhooks(ctx context.Context) ([]*model.WebhookSubscription, error) {
+	c := t.webhookSubs
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if time.Since(c.loadedAt) < webhookCacheTTL {
+		return c.subs, nil
+	}
+	subs, err := webhooksubscriptions.ListEnabled(ctx, t.DB, t.ChainID, t.Address)
+	if err != nil {
+		return nil, err
+	}
+	c.subs, c.loadedAt = subs, time.Now()
+	return subs, nil
+}
+
+// saveWebhookDeliveries 在当前事务（t.DB）中为匹配订阅条件的新事件写入待投递记录
+func (t *Task) saveWebhookDeliveries(ctx context.Context, ev *model.ContractEvent, payload *outbox.Payload) error {
+	if t.Webhooks == nil {
+		return nil
+	}
+	subs, err := t.enabledWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("saveWebhookDeliveries: list webhook_subscriptions error: %w", err)
+	}
+	var items []*model.WebhookDelivery
+	for _, sub := range subs {
+		// 只投递订阅创建之后发生的事件，初次同步和回填历史区块时不重放告警
+		if sub.CreatedAt != nil && int64(ev.BlockTimestamp) < sub.CreatedAt.Unix() {
+			continue
+		}
+		if !webhook.Match(sub, ev.EventName, payload.Args) {
+			continue
+		}
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("saveWebhookDeliveries: marshal payload error: %w", err)
+		}
+		items = append(items, &model.WebhookDelivery{
+			SubscriptionID:  sub.ID,
+			ChainID:         t.ChainID,
+			ContractAddress: ev.ContractAddress,
+			EventName:       ev.EventName,
+			BlockNumber:     ev.BlockNumber,
+			TransactionHash: ev.TransactionHash,
+			LogIndex:        ev.LogIndex,
+			Payload:         string(data),
+			Status:          webhookdeliveries.StatusPending,
+		})
+	}
+	if err := webhookdeliveries.CreateBatch(ctx, t.DB, items); err != nil {
+		return fmt.Errorf("saveWebhookDeliveries: create webhook_deliveries error: %w", err)
+	}
+	return nil
+}
+
+// deliverWebhooks 后台投递本合约到期的 webhook，失败时按指数退避重试，超过最大次数后标记为 failed
+func (t *Task) deliverWebhooks() {
+	for {
+		// 还有积压时立即继续投递
+		if t.deliverDueWebhooks() == webhookBatchSize {
+			continue
+		}
+
+		select {
+		case <-t.Context.Done():
+			logx.Info(fmt.Sprintf("webhook deliverer %s stopped", t.Name()))
+			return
+		case <-t.webhookWakeup:
+		case <-time.After(t.webhookPollInterval()):
+		}
+	}
+}
+
+// deliverDueWebhooks 投递一批到期的记录，返回本批读取的记录数。
+// 不同订阅由最多 webhook.workers 个协程并发投递，同一订阅的记录按写入顺序逐条投递
+func (t *Task) deliverDueWebhooks() int {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	deliveries, err := webhookdeliveries.ListDue(ctx, t.DB, t.ChainID, t.Address, time.Now(), webhookBatchSize)
+	if err != nil {
+		logx.Error("deliverDueWebhooks: list webhook_deliveries error: ", err)
+		return 0
+	}
+
+	var order []int64
+	bySub := make(map[int64][]*model.WebhookDelivery)
+	for _, d := range deliveries {
+		if _, ok := bySub[d.SubscriptionID]; !ok {
+			order = append(order, d.SubscriptionID)
+		}
+		bySub[d.SubscriptionID] = append(bySub[d.SubscriptionID], d)
+	}
+
+	jobs := make(chan int64)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < min(t.webhookWorkers(), len(order)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				t.deliverSubscription(ctx, id, bySub[id])
+			}
+		}()
+	}
+	for _, id := range order {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+	return len(deliveries)
+}
+
+// deliverSubscription 按顺序投递同一订阅的记录，投递失败后本批不再投递该订阅的后续记录，等待退避结束后重试
+func (t *Task) deliverSubscription(ctx context.Context, subscriptionID int64, deliveries []*model.WebhookDelivery) {
+	// ListDue 只返回已启用订阅的记录，查询失败时留待下一轮
+	sub, err := webhooksubscriptions.GetByID(ctx, t.DB, subscriptionID)
+	if err != nil {
+		logx.Error("deliverSubscription: get webhook_subscriptions error: ", err)
+		return
+	}
+	for _, d := range deliveries {
+		if t.Context.Err() != nil || !t.deliverWebhook(ctx, sub, d) {
+			return
+		}
+	}
+}
+
+// deliverWebhook 投递一条记录并更新投递状态，返回是否投递成功
+func (t *Task) deliverWebhook(ctx context.Context, sub *model.WebhookSubscription, d *model.WebhookDelivery) bool {
+	status, errDeliver := t.Webhooks.Deliver(ctx, sub, d)
+	if errDeliver == nil {
+		if err := webhookdeliveries.MarkDelivered(ctx, t.DB, d.ID, status, time.Now()); err != nil {
+			logx.Error("deliverWebhook: update webhook_deliveries error: ", err)
+		}
+		return true
+	}
+
+	attempts := d.Attempts + 1
+	var nextRetryAt *time.Time
+	if attempts < t.webhookMaxAttempts() {
+		next := time.Now().Add(retryBackoff(webhookBaseBackoff, webhookMaxBackoff, attempts))
+		nextRetryAt = &next
+	}
+	logx.Error(fmt.Sprintf("deliverWebhook: deliver failed, id=%d, subscription=%d, attempts=%d, err=%v", d.ID, sub.ID, attempts, errDeliver))
+	if err := webhookdeliveries.MarkAttemptFailed(ctx, t.DB, d.ID, status, errDeliver.Error(), nextRetryAt); err != nil {
+		logx.Error("deliverWebhook: update webhook_deliveries error: ", err)
+	}
+	return false
+}
+
+func (t *Task) webhookPollInterval() time.Duration {
+	if t.Config != nil && t.Config.Webhook != nil && t.Config.Webhook.PollInterval > 0 {
+		return t.Config.Webhook.PollInterval
+	}
+	return defaultWebhookPollInterval
+}
+
+func (t *Task) webhookMaxAttempts() int32 {
+	if t.Config != nil && t.Config.Webhook != nil && t.Config.Webhook.MaxAttempts > 0 {
+		return t.Config.Webhook.MaxAttempts
+	}
+	return defaultWebhookMaxAttempts
+}
+
+func (t *Task) webhookWorkers() int {
+	if t.Config != nil && t.Config.Webhook != nil && t.Config.Webhook.Workers > 0 {
+		return t.Config.Webhook.Workers
+	}
+	return defaultWebhookWorkers
+}
@@ -0,0 +1,7 @@
+package service
+
+// 注册合约处理模块：新增合约类型时在此引入对应的模块包
+import (
+	_ "github.com/dijiacoder/MetaNodeStakeSync/app/service/stake"
+	_ "github.com/dijiacoder/MetaNodeStakeSync/app/service/token"
+)
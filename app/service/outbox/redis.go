@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+)
+
+const defaultStreamPrefix = "stake_sync:events"
+
+func init() {
+	RegisterSink("redis", newRedisSink)
+}
+
+// redisSink 每个合约一个 Redis Stream，同一合约的消息按发布顺序追加
+type redisSink struct {
+	client *redis.Client
+	prefix string
+	maxLen int64
+}
+
+func newRedisSink(cfg *config.OutboxConfig, redisClient *redis.Client) (Sink, error) {
+	if redisClient == nil {
+		return nil, fmt.Errorf("newRedisSink: redis client is missing")
+	}
+	s := &redisSink{client: redisClient, prefix: cfg.StreamPrefix, maxLen: cfg.MaxLen}
+	if s.prefix == "" {
+		s.prefix = defaultStreamPrefix
+	}
+	return s, nil
+}
+
+func (s *redisSink) Name() string {
+	return "redis"
+}
+
+// StreamKey 合约消息所在的 Stream 键
+func (s *redisSink) StreamKey(chainID int32, contract string) string {
+	return fmt.Sprintf("%s:%d:%s", s.prefix, chainID, strings.ToLower(contract))
+}
+
+// Publish 用一个 pipeline 顺序执行 XADD，pipeline 在同一连接上按顺序执行，遇到第一条失败即停止计数
+func (s *redisSink) Publish(ctx context.Context, msgs []*Message) (int, error) {
+	if len(msgs) == 0 {
+		return 0, nil
+	}
+	pipe := s.client.Pipeline()
+	cmds := make([]*redis.StringCmd, 0, len(msgs))
+	for _, m := range msgs {
+		cmds = append(cmds, pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: s.StreamKey(m.ChainID, m.Contract),
+			MaxLen: s.maxLen,
+			Approx: s.maxLen > 0,
+			Values: map[string]interface{}{
+				"id":      m.ID,
+				"kind":    m.Kind,
+				"event":   m.Event,
+				"block":   m.Block,
+				"payload": m.Payload,
+			},
+		}))
+	}
+	_, _ = pipe.Exec(ctx)
+
+	for i, cmd := range cmds {
+		if err := cmd.Err(); err != nil {
+			return i, fmt.Errorf("Publish: xadd outbox message %d error: %w", msgs[i].ID, err)
+		}
+	}
+	return len(msgs), nil
+}
@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+)
+
+// Message 发件箱中的一条待发布消息
+type Message struct {
+	ID       int64 // event_outbox.id，同一合约内递增，消费方据此排序和去重
+	ChainID  int32
+	Contract string
+	Kind     string // event / retract
+	Event    string
+	Block    uint64
+	Payload  []byte // Payload 的 JSON 编码
+}
+
+// Payload 消息内容。retract 消息与被撤回的 event 消息具有相同的 (transaction_hash, log_index)
+type Payload struct {
+	Kind            string                 `json:"kind"`
+	ChainID         int32                  `json:"chain_id"`
+	Contract        string                 `json:"contract"`
+	Event           string                 `json:"event"`
+	BlockNumber     uint64                 `json:"block_number"`
+	BlockTimestamp  uint64                 `json:"block_timestamp"`
+	TransactionHash string                 `json:"transaction_hash"`
+	LogIndex        int32                  `json:"log_index"`
+	Topics          []string               `json:"topics"`
+	Data            string                 `json:"data"`
+	Args            map[string]interface{} `json:"args,omitempty"` // 按 ABI 解码的事件参数，整数为十进制字符串
+}
+
+// Sink 消息总线。同一次 Publish 的消息属于同一合约，必须按顺序发布；
+// 返回从第一条开始连续发布成功的条数，其余消息由 relay 在下一轮重试，因此消费方需按 Message.ID 去重
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, msgs []*Message) (int, error)
+}
+
+// SinkFactory 按配置创建 Sink，redisClient 为服务共用的 Redis 客户端
+type SinkFactory func(cfg *config.OutboxConfig, redisClient *redis.Client) (Sink, error)
+
+var sinkFactories = make(map[string]SinkFactory)
+
+// RegisterSink 注册消息总线实现，通常在实现所在文件的 init 中调用
+func RegisterSink(name string, factory SinkFactory) {
+	if _, ok := sinkFactories[name]; ok {
+		panic(fmt.Sprintf("outbox: sink %s registered twice", name))
+	}
+	sinkFactories[name] = factory
+}
+
+// NewSink 创建 cfg.Sink 指定的消息总线
+func NewSink(cfg *config.OutboxConfig, redisClient *redis.Client) (Sink, error) {
+	factory, ok := sinkFactories[cfg.Sink]
+	if !ok {
+		names := make([]string, 0, len(sinkFactories))
+		for name := range sinkFactories {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("NewSink: unknown sink %q, available: %s", cfg.Sink, strings.Join(names, ", "))
+	}
+	return factory(cfg, redisClient)
+}
@@ -0,0 +1,186 @@
+package reward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractstate"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/syncstatus"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	"gorm.io/gorm"
+)
+
+// ErrParamsUnknown stake_contract_state 尚未同步或参数不完整，无法在链下计算奖励
+var ErrParamsUnknown = errors.New("reward params unknown")
+
+// LoadParams 从 stake_contract_state（由 SetStartBlock/SetEndBlock/SetMetaNodePerBlock 事件维护）和 pool_info 读取合约全局参数，
+// 参数尚未同步时返回 ErrParamsUnknown
+func LoadParams(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, pool *model.PoolInfo) (Params, error) {
+	state, err := contractstate.GetByContract(ctx, db, chainID, contractAddress)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Params{}, fmt.Errorf("%w: no stake_contract_state of %s", ErrParamsUnknown, contractAddress)
+	}
+	if err != nil {
+		return Params{}, fmt.Errorf("get stake_contract_state error: %w", err)
+	}
+	return paramsFromModel(state, pool)
+}
+
+// LoadParamsAt 从 stake_contract_state_history 读取合约在 blockNumber 区块生效的全局参数，
+// 该区块早于参数历史的起点（初始化读取的区块）时返回 ErrParamsUnknown
+func LoadParamsAt(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, pool *model.PoolInfo, blockNumber uint64) (Params, error) {
+	history, err := contractstate.GetHistoryAt(ctx, db, chainID, contractAddress, blockNumber)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Params{}, fmt.Errorf("%w: no stake_contract_state_history of %s at block %d", ErrParamsUnknown, contractAddress, blockNumber)
+	}
+	if err != nil {
+		return Params{}, fmt.Errorf("get stake_contract_state_history error: %w", err)
+	}
+	return paramsFromModel(&model.StakeContractState{
+		ChainID:          history.ChainID,
+		ContractAddress:  history.ContractAddress,
+		StartBlock:       history.StartBlock,
+		EndBlock:         history.EndBlock,
+		MetanodePerBlock: history.MetanodePerBlock,
+	}, pool)
+}
+
+func paramsFromModel(state *model.StakeContractState, pool *model.PoolInfo) (Params, error) {
+	if state.StartBlock == nil || state.EndBlock == nil || state.MetanodePerBlock == nil {
+		return Params{}, fmt.Errorf("%w: stake_contract_state of %s is incomplete", ErrParamsUnknown, state.ContractAddress)
+	}
+	if pool.TotalPoolWeight == nil {
+		return Params{}, fmt.Errorf("%w: total_pool_weight of pool %d is unknown", ErrParamsUnknown, pool.PoolID)
+	}
+	return Params{
+		StartBlock:       *state.StartBlock,
+		EndBlock:         *state.EndBlock,
+		MetaNodePerBlock: state.MetanodePerBlock.Big(),
+		TotalPoolWeight:  pool.TotalPoolWeight.Big(),
+	}, nil
+}
+
+// PoolFromModel 将 pool_info 记录转换为奖励计算使用的资金池
+func PoolFromModel(pool *model.PoolInfo) Pool {
+	res := Pool{
+		PoolWeight:       pool.PoolWeight.Big(),
+		LastRewardBlock:  pool.LastRewardBlock,
+		AccMetaNodePerST: big.NewInt(0),
+		StTokenAmount:    big.NewInt(0),
+	}
+	if pool.AccMetanodePerSt != nil {
+		res.AccMetaNodePerST = pool.AccMetanodePerSt.Big()
+	}
+	if pool.StTokenAmount != nil {
+		res.StTokenAmount = pool.StTokenAmount.Big()
+	}
+	return res
+}
+
+// UserFromModel 将 user_pool_stats 记录转换为奖励计算使用的用户状态，nil 表示用户从未参与该池。
+// 合约的 user.pendingMetaNode 对应上次操作时结算的 settled_metanode，而不是按区块刷新的 pending_metanode
+func UserFromModel(stats *model.UserPoolStat) User {
+	res := User{
+		StAmount:         big.NewInt(0),
+		FinishedMetaNode: big.NewInt(0),
+		PendingMetaNode:  big.NewInt(0),
+	}
+	if stats == nil {
+		return res
+	}
+	if stats.StAmount != nil {
+		res.StAmount = stats.StAmount.Big()
+	}
+	if stats.FinishedMetanode != nil {
+		res.FinishedMetaNode = stats.FinishedMetanode.Big()
+	}
+	if stats.SettledMetanode != nil {
+		res.PendingMetaNode = stats.SettledMetanode.Big()
+	}
+	return res
+}
+
+// PendingMetaNodeAtSyncedHead 基于已同步的数据计算用户在同步进度处的区块可领取的 MetaNode，等价于合约 pendingMetaNode。
+// 资金池、用户状态和合约参数都是同步进度处的值，因此只能计算该区块，不支持任意区块
+func PendingMetaNodeAtSyncedHead(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, poolID int32, userAddress string) (*big.Int, error) {
+	status, err := syncstatus.GetByContractAndChain(ctx, db, contractAddress, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("get sync_status error: %w", err)
+	}
+	pool, err := poolinfo.GetByPoolIDAndContract(ctx, db, chainID, poolID, contractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("get pool_info error: %w", err)
+	}
+	params, err := LoadParams(ctx, db, chainID, contractAddress, pool)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := userpoolstats.GetByUserPoolAndContract(ctx, db, chainID, userAddress, poolID, contractAddress)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("get user_pool_stats error: %w", err)
+	}
+	return PendingMetaNodeByBlockNumber(params, PoolFromModel(pool), UserFromModel(stats), status.LastSyncedBlock)
+}
+
+// RefreshPending 把持仓的 pending_metanode 替换为同步进度处的待领取奖励，只修改内存中的记录。
+// 表中的 pending_metanode 只在处理涉及该资金池的区间时刷新，查询时按同步进度重新计算；合约尚未同步时保留表中的值
+func RefreshPending(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, positions []*model.UserPoolStat) error {
+	if len(positions) == 0 {
+		return nil
+	}
+	status, err := syncstatus.GetByContractAndChain(ctx, db, contractAddress, chainID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("RefreshPending: get sync_status error: %w", err)
+	}
+	return RefreshPendingAt(ctx, db, chainID, contractAddress, positions, status.LastSyncedBlock)
+}
+
+// RefreshPendingAt 按已结算的 settled_metanode 把持仓的 pending_metanode 替换为 blockNumber 时的待领取奖励，只修改内存中的记录。
+// 参数未知或区块早于奖励开始区块时保留原值
+func RefreshPendingAt(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, positions []*model.UserPoolStat, blockNumber uint64) error {
+	if len(positions) == 0 {
+		return nil
+	}
+	state, err := contractstate.GetByContract(ctx, db, chainID, contractAddress)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("RefreshPendingAt: get stake_contract_state error: %w", err)
+	}
+	pools, err := poolinfo.ListByContract(ctx, db, chainID, contractAddress)
+	if err != nil {
+		return fmt.Errorf("RefreshPendingAt: list pool_info error: %w", err)
+	}
+	poolByID := make(map[int32]*model.PoolInfo, len(pools))
+	for _, p := range pools {
+		poolByID[p.PoolID] = p
+	}
+
+	for _, position := range positions {
+		pool, ok := poolByID[position.PoolID]
+		if !ok {
+			continue
+		}
+		params, err := paramsFromModel(state, pool)
+		if err != nil {
+			continue
+		}
+		pending, err := PendingMetaNodeByBlockNumber(params, PoolFromModel(pool), UserFromModel(position), blockNumber)
+		if err != nil {
+			// 与合约相同，区块早于 startBlock 时无法计算，此时尚未产生奖励
+			continue
+		}
+		v := types.NewBigInt(pending)
+		position.PendingMetanode = &v
+	}
+	return nil
+}
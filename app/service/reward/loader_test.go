@@ -0,0 +1,167 @@
+package reward
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+const (
+	testChainID  = 1
+	testContract = "0x1111111111111111111111111111111111111111"
+)
+
+// 金额列使用 TEXT，避免 sqlite 把大整数转为浮点数
+var testSchema = []string{
+	`CREATE TABLE sync_status (id INTEGER PRIMARY KEY AUTOINCREMENT, contract_address TEXT, chain_id INT, last_synced_block INT,
+		last_sync_time TIMESTAMP, sync_error TEXT, is_syncing BOOLEAN, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE stake_contract_state (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, metanode_token TEXT,
+		start_block INT, end_block INT, metanode_per_block TEXT, withdraw_paused BOOLEAN, claim_paused BOOLEAN, paused BOOLEAN,
+		last_updated_block INT, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE pool_info (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, pool_id INT, contract_address TEXT, st_token_address TEXT,
+		pool_weight TEXT, last_reward_block INT, acc_metanode_per_st TEXT, st_token_amount TEXT, min_deposit_amount TEXT,
+		unstake_locked_blocks INT, total_pool_weight TEXT, is_active BOOLEAN, created_block INT, created_tx TEXT,
+		created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE stake_contract_state_history (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, block_number INT,
+		metanode_token TEXT, start_block INT, end_block INT, metanode_per_block TEXT, withdraw_paused BOOLEAN, claim_paused BOOLEAN,
+		paused BOOLEAN, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE user_pool_stats (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, user_address TEXT, pool_id INT, contract_address TEXT,
+		st_amount TEXT DEFAULT '0', finished_metanode TEXT DEFAULT '0', pending_metanode TEXT DEFAULT '0', settled_metanode TEXT DEFAULT '0',
+		total_deposited TEXT DEFAULT '0', total_unstaked TEXT DEFAULT '0', total_withdrawn TEXT DEFAULT '0', total_claimed TEXT DEFAULT '0',
+		last_deposit_block INT, last_claim_block INT, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+}
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range testSchema {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	return db
+}
+
+// newPosition 区块 120 解质押后的用户状态，见 TestDepositUnstakeClaim
+func newPosition() *model.UserPoolStat {
+	st, finished, pending, settled := types.NewBigIntFromInt64(600), types.NewBigIntFromInt64(600), types.NewBigIntFromInt64(1000), types.NewBigIntFromInt64(1000)
+	return &model.UserPoolStat{ChainID: testChainID, UserAddress: "0x2222222222222222222222222222222222222222", PoolID: 0, ContractAddress: testContract,
+		StAmount: &st, FinishedMetanode: &finished, PendingMetanode: &pending, SettledMetanode: &settled}
+}
+
+func TestRefreshPending(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	db.Exec(`INSERT INTO pool_info (chain_id, pool_id, contract_address, pool_weight, last_reward_block, acc_metanode_per_st, st_token_amount, total_pool_weight)
+		VALUES (1, 0, ?, '100', 120, '1000000000000000000', '600', '100')`, testContract)
+
+	// 未同步时保留已结算的值
+	position := newPosition()
+	if err := RefreshPending(ctx, db, testChainID, testContract, []*model.UserPoolStat{position}); err != nil {
+		t.Fatal(err)
+	}
+	if got := position.PendingMetanode.String(); got != "1000" {
+		t.Fatalf("pending without sync_status = %s, want 1000", got)
+	}
+
+	// 合约参数尚未同步时保留已结算的值，LoadParams 返回 ErrParamsUnknown
+	db.Exec(`INSERT INTO sync_status (contract_address, chain_id, last_synced_block) VALUES (?, 1, 130)`, testContract)
+	if err := RefreshPending(ctx, db, testChainID, testContract, []*model.UserPoolStat{position}); err != nil {
+		t.Fatal(err)
+	}
+	if got := position.PendingMetanode.String(); got != "1000" {
+		t.Fatalf("pending without stake_contract_state = %s, want 1000", got)
+	}
+	if _, err := LoadParams(ctx, db, testChainID, testContract, &model.PoolInfo{}); !errors.Is(err, ErrParamsUnknown) {
+		t.Fatalf("LoadParams without stake_contract_state: err = %v, want ErrParamsUnknown", err)
+	}
+
+	// 按同步进度（区块 130）计算：1599 - 600 + 1000
+	db.Exec(`INSERT INTO stake_contract_state (chain_id, contract_address, start_block, end_block, metanode_per_block) VALUES (1, ?, 100, 1000, '100')`, testContract)
+	if err := RefreshPending(ctx, db, testChainID, testContract, []*model.UserPoolStat{position}); err != nil {
+		t.Fatal(err)
+	}
+	if got := position.PendingMetanode.String(); got != "1999" {
+		t.Fatalf("pending at synced head = %s, want 1999", got)
+	}
+
+	// 按 settled_metanode 重新计算（2599 - 600 + 1000），已刷新的 pending_metanode 不会重复累加
+	if err := RefreshPendingAt(ctx, db, testChainID, testContract, []*model.UserPoolStat{position}, 140); err != nil {
+		t.Fatal(err)
+	}
+	if got := position.PendingMetanode.String(); got != "2999" {
+		t.Fatalf("pending at block 140 = %s, want 2999", got)
+	}
+}
+
+func TestPendingMetaNodeAtSyncedHead(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	user := newPosition().UserAddress
+	db.Exec(`INSERT INTO pool_info (chain_id, pool_id, contract_address, pool_weight, last_reward_block, acc_metanode_per_st, st_token_amount, total_pool_weight)
+		VALUES (1, 0, ?, '100', 120, '1000000000000000000', '600', '100')`, testContract)
+	db.Exec(`INSERT INTO stake_contract_state (chain_id, contract_address, start_block, end_block, metanode_per_block) VALUES (1, ?, 100, 1000, '100')`, testContract)
+	// pending_metanode 为上次刷新的值，计算使用已结算的 settled_metanode
+	db.Exec(`INSERT INTO user_pool_stats (chain_id, user_address, pool_id, contract_address, st_amount, finished_metanode, pending_metanode, settled_metanode)
+		VALUES (1, ?, 0, ?, '600', '600', '1500', '1000')`, user, testContract)
+
+	if _, err := PendingMetaNodeAtSyncedHead(ctx, db, testChainID, testContract, 0, user); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("PendingMetaNodeAtSyncedHead without sync_status: err = %v, want ErrRecordNotFound", err)
+	}
+
+	db.Exec(`INSERT INTO sync_status (contract_address, chain_id, last_synced_block) VALUES (?, 1, 130)`, testContract)
+	tests := []struct {
+		name string
+		user string
+		want string
+	}{
+		{"position", user, "1999"},
+		{"no position", "0x3333333333333333333333333333333333333333", "0"},
+	}
+	for _, tt := range tests {
+		got, err := PendingMetaNodeAtSyncedHead(ctx, db, testChainID, testContract, 0, tt.user)
+		if err != nil {
+			t.Fatalf("%s: PendingMetaNodeAtSyncedHead() error: %v", tt.name, err)
+		}
+		if got.String() != tt.want {
+			t.Fatalf("%s: PendingMetaNodeAtSyncedHead() = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLoadParamsAt(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	totalWeight := types.NewBigIntFromInt64(100)
+	pool := &model.PoolInfo{TotalPoolWeight: &totalWeight}
+
+	// 链 2 上同一地址的合约参数不影响链 1
+	db.Exec(`INSERT INTO stake_contract_state_history (chain_id, contract_address, block_number, start_block, end_block, metanode_per_block)
+		VALUES (1, ?, 50, 100, 1000, '100'), (1, ?, 300, 100, 1000, '40'), (2, ?, 10, 100, 1000, '7'), (2, ?, 299, 100, 1000, '7')`,
+		testContract, testContract, testContract, testContract)
+
+	if _, err := LoadParamsAt(ctx, db, testChainID, testContract, pool, 49); !errors.Is(err, ErrParamsUnknown) {
+		t.Fatalf("LoadParamsAt before history: err = %v, want ErrParamsUnknown", err)
+	}
+	for _, tt := range []struct {
+		block uint64
+		want  string
+	}{{50, "100"}, {299, "100"}, {300, "40"}, {500, "40"}} {
+		params, err := LoadParamsAt(ctx, db, testChainID, testContract, pool, tt.block)
+		if err != nil {
+			t.Fatalf("LoadParamsAt(%d) error: %v", tt.block, err)
+		}
+		if params.MetaNodePerBlock.String() != tt.want || params.StartBlock != 100 || params.TotalPoolWeight.Int64() != 100 {
+			t.Fatalf("LoadParamsAt(%d) = %+v, want MetaNodePerBlock %s", tt.block, params, tt.want)
+		}
+	}
+}
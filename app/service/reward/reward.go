@@ -0,0 +1,192 @@
+// Package reward 按 MetaNodeStake.sol 的整数运算规则在链下计算 MetaNode 奖励，
+// 以便直接基于已同步的数据得到任意区块的待领取奖励，而无需逐个用户调用合约。
+package reward
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	ErrInvalidBlock       = errors.New("invalid block")
+	ErrInvalidBlockRange  = errors.New("end block must be greater than start block")
+	ErrZeroTotalWeight    = errors.New("total pool weight is zero")
+	ErrNotEnoughStAmount  = errors.New("not enough staking token balance")
+	ErrFinishedExceedsAcc = errors.New("accST sub finishedMetaNode overflow")
+)
+
+// oneEther 合约中的 1 ether，accMetaNodePerST 以该精度放大
+var oneEther = big.NewInt(1e18)
+
+// Params 合约全局参数，对应 startBlock、endBlock、MetaNodePerBlock、totalPoolWeight
+type Params struct {
+	StartBlock       uint64
+	EndBlock         uint64
+	MetaNodePerBlock *big.Int
+	TotalPoolWeight  *big.Int
+}
+
+// Pool 资金池中参与奖励计算的字段，对应合约 Pool 结构
+type Pool struct {
+	PoolWeight       *big.Int
+	LastRewardBlock  uint64
+	AccMetaNodePerST *big.Int
+	StTokenAmount    *big.Int
+}
+
+// User 用户在资金池中的奖励状态，对应合约 User 结构
+type User struct {
+	StAmount         *big.Int
+	FinishedMetaNode *big.Int
+	PendingMetaNode  *big.Int
+}
+
+// GetMultiplier 对应合约 getMultiplier：[from, to) 区间内的奖励总量，区间会被裁剪到 [startBlock, endBlock]
+func GetMultiplier(params Params, from, to uint64) (*big.Int, error) {
+	if from > to {
+		return nil, ErrInvalidBlock
+	}
+	if from < params.StartBlock {
+		from = params.StartBlock
+	}
+	if to > params.EndBlock {
+		to = params.EndBlock
+	}
+	if from > to {
+		return nil, ErrInvalidBlockRange
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(to-from), params.MetaNodePerBlock), nil
+}
+
+// UpdatePool 对应合约 updatePool：返回更新到 blockNumber 后的资金池以及本次分配给该池的 totalMetaNode。
+// blockNumber <= lastRewardBlock 时资金池不变，totalMetaNode 为 0。
+func UpdatePool(params Params, pool Pool, blockNumber uint64) (Pool, *big.Int, error) {
+	if blockNumber <= pool.LastRewardBlock {
+		return pool, big.NewInt(0), nil
+	}
+	if params.TotalPoolWeight.Sign() == 0 {
+		return pool, nil, ErrZeroTotalWeight
+	}
+
+	multiplier, err := GetMultiplier(params, pool.LastRewardBlock, blockNumber)
+	if err != nil {
+		return pool, nil, err
+	}
+	totalMetaNode := new(big.Int).Mul(multiplier, pool.PoolWeight)
+	totalMetaNode.Quo(totalMetaNode, params.TotalPoolWeight)
+
+	updated := pool
+	updated.AccMetaNodePerST = AccumulateMetaNode(pool.AccMetaNodePerST, totalMetaNode, pool.StTokenAmount)
+	updated.LastRewardBlock = blockNumber
+	return updated, totalMetaNode, nil
+}
+
+// AccumulateMetaNode 对应合约 updatePool 中 accMetaNodePerST 的累加：stSupply > 0 时返回
+// accMetaNodePerST + totalMetaNode * 1 ether / stSupply，否则返回 accMetaNodePerST 的副本
+func AccumulateMetaNode(accMetaNodePerST, totalMetaNode, stSupply *big.Int) *big.Int {
+	res := new(big.Int).Set(accMetaNodePerST)
+	if stSupply.Sign() <= 0 {
+		return res
+	}
+	increment := new(big.Int).Mul(totalMetaNode, oneEther)
+	increment.Quo(increment, stSupply)
+	return res.Add(res, increment)
+}
+
+// PendingMetaNodeByBlockNumber 对应合约 pendingMetaNodeByBlockNumber：用户在 blockNumber 时可领取的 MetaNode
+func PendingMetaNodeByBlockNumber(params Params, pool Pool, user User, blockNumber uint64) (*big.Int, error) {
+	accMetaNodePerST := new(big.Int).Set(pool.AccMetaNodePerST)
+	if blockNumber > pool.LastRewardBlock && pool.StTokenAmount.Sign() != 0 {
+		if params.TotalPoolWeight.Sign() == 0 {
+			return nil, ErrZeroTotalWeight
+		}
+		multiplier, err := GetMultiplier(params, pool.LastRewardBlock, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+		metaNodeForPool := new(big.Int).Mul(multiplier, pool.PoolWeight)
+		metaNodeForPool.Quo(metaNodeForPool, params.TotalPoolWeight)
+		accMetaNodePerST = AccumulateMetaNode(accMetaNodePerST, metaNodeForPool, pool.StTokenAmount)
+	}
+
+	accrued, err := accruedSinceFinished(user, accMetaNodePerST)
+	if err != nil {
+		return nil, err
+	}
+	return accrued.Add(accrued, user.PendingMetaNode), nil
+}
+
+// Deposit 对应合约 _deposit 中 updatePool 之后的用户记账，pool 需已更新到当前区块
+func Deposit(pool Pool, user User, amount *big.Int) (Pool, User, error) {
+	updatedUser := user
+	if user.StAmount.Sign() > 0 {
+		pending, err := accruedSinceFinished(user, pool.AccMetaNodePerST)
+		if err != nil {
+			return pool, user, err
+		}
+		if pending.Sign() > 0 {
+			updatedUser.PendingMetaNode = new(big.Int).Add(user.PendingMetaNode, pending)
+		}
+	}
+	updatedUser.StAmount = new(big.Int).Add(user.StAmount, amount)
+
+	updatedPool := pool
+	updatedPool.StTokenAmount = new(big.Int).Add(pool.StTokenAmount, amount)
+
+	updatedUser.FinishedMetaNode = finished(updatedUser.StAmount, pool.AccMetaNodePerST)
+	return updatedPool, updatedUser, nil
+}
+
+// Unstake 对应合约 unstake 中 updatePool 之后的用户记账，pool 需已更新到当前区块
+func Unstake(pool Pool, user User, amount *big.Int) (Pool, User, error) {
+	if user.StAmount.Cmp(amount) < 0 {
+		return pool, user, ErrNotEnoughStAmount
+	}
+
+	updatedUser := user
+	pending, err := accruedSinceFinished(user, pool.AccMetaNodePerST)
+	if err != nil {
+		return pool, user, err
+	}
+	if pending.Sign() > 0 {
+		updatedUser.PendingMetaNode = new(big.Int).Add(user.PendingMetaNode, pending)
+	}
+	updatedUser.StAmount = new(big.Int).Sub(user.StAmount, amount)
+
+	updatedPool := pool
+	updatedPool.StTokenAmount = new(big.Int).Sub(pool.StTokenAmount, amount)
+
+	updatedUser.FinishedMetaNode = finished(updatedUser.StAmount, pool.AccMetaNodePerST)
+	return updatedPool, updatedUser, nil
+}
+
+// Claim 对应合约 claim 中 updatePool 之后的用户记账，返回更新后的用户和本次领取的 MetaNode
+func Claim(pool Pool, user User) (User, *big.Int, error) {
+	accrued, err := accruedSinceFinished(user, pool.AccMetaNodePerST)
+	if err != nil {
+		return user, nil, err
+	}
+	claimed := accrued.Add(accrued, user.PendingMetaNode)
+
+	updatedUser := user
+	if claimed.Sign() > 0 {
+		updatedUser.PendingMetaNode = big.NewInt(0)
+	}
+	updatedUser.FinishedMetaNode = finished(user.StAmount, pool.AccMetaNodePerST)
+	return updatedUser, claimed, nil
+}
+
+// accruedSinceFinished stAmount * accMetaNodePerST / 1 ether - finishedMetaNode，结果为负时合约会回滚
+func accruedSinceFinished(user User, accMetaNodePerST *big.Int) (*big.Int, error) {
+	accrued := finished(user.StAmount, accMetaNodePerST)
+	accrued.Sub(accrued, user.FinishedMetaNode)
+	if accrued.Sign() < 0 {
+		return nil, ErrFinishedExceedsAcc
+	}
+	return accrued, nil
+}
+
+func finished(stAmount, accMetaNodePerST *big.Int) *big.Int {
+	res := new(big.Int).Mul(stAmount, accMetaNodePerST)
+	return res.Quo(res, oneEther)
+}
@@ -0,0 +1,233 @@
+package reward
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func bi(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid big int " + s)
+	}
+	return v
+}
+
+func TestGetMultiplier(t *testing.T) {
+	params := Params{StartBlock: 100, EndBlock: 200, MetaNodePerBlock: big.NewInt(10)}
+	tests := []struct {
+		name     string
+		from, to uint64
+		want     int64
+		wantErr  error
+	}{
+		{"inside", 120, 130, 100, nil},
+		{"before start", 50, 150, 500, nil},
+		{"after end", 150, 250, 500, nil},
+		{"covers whole", 50, 250, 1000, nil},
+		{"empty", 100, 100, 0, nil},
+		{"to before start", 10, 50, 0, ErrInvalidBlockRange},
+		{"from after end", 250, 300, 0, ErrInvalidBlockRange},
+		{"from after to", 150, 120, 0, ErrInvalidBlock},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetMultiplier(params, tt.from, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.Int64() != tt.want {
+				t.Fatalf("got %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccumulateMetaNode(t *testing.T) {
+	tests := []struct {
+		name                 string
+		acc, total, stSupply string
+		want                 string
+	}{
+		{"no supply", "5", "1000", "0", "5"},
+		{"exact", "5", "3", "1000000000000000000", "8"},
+		// 整数除法向下取整：2 * 1e18 / 3
+		{"truncated", "0", "2", "3", "666666666666666666"},
+	}
+	for _, tt := range tests {
+		acc := bi(tt.acc)
+		got := AccumulateMetaNode(acc, bi(tt.total), bi(tt.stSupply))
+		if got.String() != tt.want {
+			t.Errorf("%s: AccumulateMetaNode() = %s, want %s", tt.name, got, tt.want)
+		}
+		if acc.String() != tt.acc {
+			t.Errorf("%s: accMetaNodePerST modified to %s", tt.name, acc)
+		}
+	}
+}
+
+func TestUpdatePool(t *testing.T) {
+	params := Params{StartBlock: 0, EndBlock: 1000, MetaNodePerBlock: bi("1000000000000000000"), TotalPoolWeight: big.NewInt(3)}
+	tests := []struct {
+		name          string
+		params        Params
+		pool          Pool
+		block         uint64
+		wantAcc       string
+		wantLast      uint64
+		wantTotalNode string
+		wantErr       error
+	}{
+		{
+			// stSupply == 0：只推进 lastRewardBlock，accMetaNodePerST 不变
+			name:          "no stake",
+			params:        params,
+			pool:          Pool{PoolWeight: big.NewInt(1), LastRewardBlock: 100, AccMetaNodePerST: big.NewInt(0), StTokenAmount: big.NewInt(0)},
+			block:         104,
+			wantAcc:       "0",
+			wantLast:      104,
+			wantTotalNode: "1333333333333333333",
+		},
+		{
+			// 4 个区块 * 1e18 * 1/3 = 1333333333333333333，accMetaNodePerST += 1333333333333333333 * 1e18 / 7
+			name:          "with stake",
+			params:        params,
+			pool:          Pool{PoolWeight: big.NewInt(1), LastRewardBlock: 100, AccMetaNodePerST: big.NewInt(5), StTokenAmount: big.NewInt(7)},
+			block:         104,
+			wantAcc:       "190476190476190476142857142857142862",
+			wantLast:      104,
+			wantTotalNode: "1333333333333333333",
+		},
+		{
+			name:          "not advanced",
+			params:        params,
+			pool:          Pool{PoolWeight: big.NewInt(1), LastRewardBlock: 100, AccMetaNodePerST: big.NewInt(5), StTokenAmount: big.NewInt(7)},
+			block:         100,
+			wantAcc:       "5",
+			wantLast:      100,
+			wantTotalNode: "0",
+		},
+		{
+			name:    "zero total weight",
+			params:  Params{StartBlock: 0, EndBlock: 1000, MetaNodePerBlock: big.NewInt(1), TotalPoolWeight: big.NewInt(0)},
+			pool:    Pool{PoolWeight: big.NewInt(1), LastRewardBlock: 100, AccMetaNodePerST: big.NewInt(0), StTokenAmount: big.NewInt(1)},
+			block:   104,
+			wantErr: ErrZeroTotalWeight,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool, totalMetaNode, err := UpdatePool(tt.params, tt.pool, tt.block)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if pool.AccMetaNodePerST.String() != tt.wantAcc || pool.LastRewardBlock != tt.wantLast || totalMetaNode.String() != tt.wantTotalNode {
+				t.Fatalf("got acc=%s last=%d total=%s, want acc=%s last=%d total=%s",
+					pool.AccMetaNodePerST, pool.LastRewardBlock, totalMetaNode, tt.wantAcc, tt.wantLast, tt.wantTotalNode)
+			}
+		})
+	}
+}
+
+// TestDepositUnstakeClaim 单个资金池（权重占全部），每区块 100 MetaNode：
+// 区块 110 存入 1000，区块 120 解质押 400，区块 130 领取
+func TestDepositUnstakeClaim(t *testing.T) {
+	params := Params{StartBlock: 100, EndBlock: 1000, MetaNodePerBlock: big.NewInt(100), TotalPoolWeight: big.NewInt(100)}
+	pool := Pool{PoolWeight: big.NewInt(100), LastRewardBlock: 100, AccMetaNodePerST: big.NewInt(0), StTokenAmount: big.NewInt(0)}
+	user := User{StAmount: big.NewInt(0), FinishedMetaNode: big.NewInt(0), PendingMetaNode: big.NewInt(0)}
+
+	steps := []struct {
+		name  string
+		block uint64
+		apply func(pool Pool, user User) (Pool, User, *big.Int, error)
+		// 操作后的状态
+		wantAcc, wantPoolSt               string
+		wantSt, wantFinished, wantPending string
+		wantClaimed                       string
+		wantPendingBefore                 string // 操作前按区块计算的待领取奖励
+	}{
+		{
+			name:  "deposit",
+			block: 110,
+			apply: func(pool Pool, user User) (Pool, User, *big.Int, error) {
+				pool, user, err := Deposit(pool, user, big.NewInt(1000))
+				return pool, user, nil, err
+			},
+			wantAcc: "0", wantPoolSt: "1000",
+			wantSt: "1000", wantFinished: "0", wantPending: "0",
+			wantPendingBefore: "0",
+		},
+		{
+			// 10 个区块 * 100 = 1000，accMetaNodePerST = 1000 * 1e18 / 1000 = 1e18，结算 1000 到 pendingMetaNode
+			name:  "unstake",
+			block: 120,
+			apply: func(pool Pool, user User) (Pool, User, *big.Int, error) {
+				pool, user, err := Unstake(pool, user, big.NewInt(400))
+				return pool, user, nil, err
+			},
+			wantAcc: "1000000000000000000", wantPoolSt: "600",
+			wantSt: "600", wantFinished: "600", wantPending: "1000",
+			wantPendingBefore: "1000",
+		},
+		{
+			// accMetaNodePerST += 1000 * 1e18 / 600 = 2666666666666666666，
+			// 领取 600 * acc / 1e18 - 600 + 1000 = 1599 - 600 + 1000 = 1999
+			name:  "claim",
+			block: 130,
+			apply: func(pool Pool, user User) (Pool, User, *big.Int, error) {
+				user, claimed, err := Claim(pool, user)
+				return pool, user, claimed, err
+			},
+			wantAcc: "2666666666666666666", wantPoolSt: "600",
+			wantSt: "600", wantFinished: "1599", wantPending: "0",
+			wantClaimed:       "1999",
+			wantPendingBefore: "1999",
+		},
+	}
+	for _, step := range steps {
+		pendingBefore, err := PendingMetaNodeByBlockNumber(params, pool, user, step.block)
+		if err != nil {
+			t.Fatalf("%s: PendingMetaNodeByBlockNumber error: %v", step.name, err)
+		}
+		if pendingBefore.String() != step.wantPendingBefore {
+			t.Fatalf("%s: pending before = %s, want %s", step.name, pendingBefore, step.wantPendingBefore)
+		}
+
+		if pool, _, err = UpdatePool(params, pool, step.block); err != nil {
+			t.Fatalf("%s: UpdatePool error: %v", step.name, err)
+		}
+		var claimed *big.Int
+		if pool, user, claimed, err = step.apply(pool, user); err != nil {
+			t.Fatalf("%s: error: %v", step.name, err)
+		}
+		if pool.AccMetaNodePerST.String() != step.wantAcc || pool.StTokenAmount.String() != step.wantPoolSt {
+			t.Fatalf("%s: pool acc=%s st=%s, want acc=%s st=%s", step.name, pool.AccMetaNodePerST, pool.StTokenAmount, step.wantAcc, step.wantPoolSt)
+		}
+		if user.StAmount.String() != step.wantSt || user.FinishedMetaNode.String() != step.wantFinished || user.PendingMetaNode.String() != step.wantPending {
+			t.Fatalf("%s: user st=%s finished=%s pending=%s, want st=%s finished=%s pending=%s", step.name,
+				user.StAmount, user.FinishedMetaNode, user.PendingMetaNode, step.wantSt, step.wantFinished, step.wantPending)
+		}
+		if step.wantClaimed != "" && claimed.String() != step.wantClaimed {
+			t.Fatalf("%s: claimed = %s, want %s", step.name, claimed, step.wantClaimed)
+		}
+	}
+}
+
+func TestUserErrors(t *testing.T) {
+	pool := Pool{PoolWeight: big.NewInt(1), AccMetaNodePerST: bi("1000000000000000000"), StTokenAmount: big.NewInt(10)}
+
+	user := User{StAmount: big.NewInt(10), FinishedMetaNode: big.NewInt(0), PendingMetaNode: big.NewInt(0)}
+	if _, _, err := Unstake(pool, user, big.NewInt(11)); !errors.Is(err, ErrNotEnoughStAmount) {
+		t.Fatalf("Unstake more than staked: err = %v, want %v", err, ErrNotEnoughStAmount)
+	}
+
+	// finishedMetaNode 超过 stAmount * accMetaNodePerST 时合约会回滚
+	user.FinishedMetaNode = big.NewInt(11)
+	if _, _, err := Claim(pool, user); !errors.Is(err, ErrFinishedExceedsAcc) {
+		t.Fatalf("Claim: err = %v, want %v", err, ErrFinishedExceedsAcc)
+	}
+}
@@ -0,0 +1,106 @@
+package rpcpool
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	ewmaWeight         = 0.2              // 延迟和错误率的指数移动平均权重
+	maxErrorRate       = 0.5              // 错误率超过该值时视为不健康
+	failuresToCooldown = 3                // 连续失败次数达到该值后暂停使用
+	failureCooldown    = 30 * time.Second // 暂停使用的时长
+)
+
+// endpoint 一个 RPC 端点及其健康状态
+type endpoint struct {
+	URL      string
+	Priority int32 // 越小越优先
+	limiter  *rateLimiter
+
+	mu        sync.Mutex
+	client    *ethclient.Client
+	latency   time.Duration // 请求延迟的指数移动平均
+	errorRate float64       // 请求失败率的指数移动平均
+	failures  int           // 连续失败次数
+	downUntil time.Time     // 连续失败后暂停使用到该时间
+	head      uint64        // 最近一次观察到的最新区块
+	headLag   uint64        // 落后于所有端点中最高区块的区块数
+	agrees    bool          // 区块哈希与领先端点一致
+}
+
+// isWebsocketURL 判断端点是否为 ws/wss，只有 websocket 连接支持 eth_subscribe
+func isWebsocketURL(url string) bool {
+	url = strings.ToLower(url)
+	return strings.HasPrefix(url, "ws://") || strings.HasPrefix(url, "wss://")
+}
+
+// dial 按需建立连接，连接失败不影响其他端点
+func (e *endpoint) dial(ctx context.Context) (*ethclient.Client, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.client != nil {
+		return e.client, nil
+	}
+	client, err := ethclient.DialContext(ctx, e.URL)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s error: %w", e.URL, err)
+	}
+	e.client = client
+	return client, nil
+}
+
+// record 记录一次请求结果，更新延迟和错误率
+func (e *endpoint) record(latency time.Duration, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		e.errorRate = e.errorRate*(1-ewmaWeight) + ewmaWeight
+		e.failures++
+		if e.failures >= failuresToCooldown {
+			e.downUntil = time.Now().Add(failureCooldown)
+		}
+		return
+	}
+	e.errorRate *= 1 - ewmaWeight
+	e.failures = 0
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration(float64(e.latency)*(1-ewmaWeight) + float64(latency)*ewmaWeight)
+	}
+}
+
+// observeHead 记录端点返回的最新区块
+func (e *endpoint) observeHead(head uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.head = max(e.head, head)
+}
+
+// healthy 端点未处于失败暂停期、错误率不高、落后不超过 maxHeadLag 且区块哈希与领先端点一致
+func (e *endpoint) healthy(maxHeadLag uint64) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return time.Now().After(e.downUntil) && e.errorRate < maxErrorRate && e.headLag <= maxHeadLag && e.agrees
+}
+
+// score 健康评分，越小越好：平均延迟（毫秒）+ 错误率惩罚 + 落后区块惩罚
+func (e *endpoint) score() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return float64(e.latency.Milliseconds()) + e.errorRate*1000 + float64(e.headLag)*200
+}
+
+// status 端点状态描述，用于日志
+func (e *endpoint) status() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return fmt.Sprintf("url: %s, priority: %d, latency: %s, error rate: %.2f, head: %d, lag: %d, agrees: %t",
+		e.URL, e.Priority, e.latency.Round(time.Millisecond), e.errorRate, e.head, e.headLag, e.agrees)
+}
@@ -0,0 +1,395 @@
+package rpcpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second // 未配置 rpc.health_check_interval 时的健康检查间隔
+	defaultMaxHeadLag          = 5                // 未配置 rpc.max_head_lag 时允许落后的区块数
+	healthCheckTimeout         = 5 * time.Second
+)
+
+// Endpoint 端点配置
+type Endpoint struct {
+	URL       string
+	Priority  int32 // 越小越优先
+	RateLimit int32 // 每秒最多请求数，0 表示不限制
+}
+
+// Pool 一条链的多个 RPC 端点。请求固定发往当前主端点，保证同一区间的查询来自同一节点；
+// 主端点失败或不健康时按 (健康, 优先级, 健康评分) 切换到其他端点。
+// 后台定期检查各端点的最新区块，落后超过 max_head_lag 或区块哈希与领先端点不一致的端点不会被选为主端点
+type Pool struct {
+	ChainID int32
+
+	endpoints           []*endpoint
+	healthCheckInterval time.Duration
+	maxHeadLag          uint64
+
+	mu      sync.Mutex
+	primary *endpoint
+}
+
+// New 创建端点池，endpoints 不能为空；连接在首次请求时建立
+func New(chainID int32, endpoints []Endpoint, cfg *config.RPCConfig) (*Pool, error) {
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("rpcpool.New: no endpoint for chain %d", chainID)
+	}
+	p := &Pool{
+		ChainID:             chainID,
+		healthCheckInterval: defaultHealthCheckInterval,
+		maxHeadLag:          defaultMaxHeadLag,
+	}
+	if cfg != nil && cfg.HealthCheckInterval > 0 {
+		p.healthCheckInterval = cfg.HealthCheckInterval
+	}
+	if cfg != nil && cfg.MaxHeadLag > 0 {
+		p.maxHeadLag = cfg.MaxHeadLag
+	}
+	for _, ep := range endpoints {
+		p.endpoints = append(p.endpoints, &endpoint{
+			URL:      ep.URL,
+			Priority: ep.Priority,
+			limiter:  newRateLimiter(ep.RateLimit),
+			agrees:   true,
+		})
+	}
+	return p, nil
+}
+
+// Start 启动后台健康检查，ctx 结束后退出
+func (p *Pool) Start(ctx context.Context) {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(p.healthCheckInterval)
+		defer ticker.Stop()
+		for {
+			p.checkHealth(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	})
+}
+
+// SupportsSubscription 是否有 ws/wss 端点可用于 eth_subscribe
+func (p *Pool) SupportsSubscription() bool {
+	for _, e := range p.endpoints {
+		if isWebsocketURL(e.URL) {
+			return true
+		}
+	}
+	return false
+}
+
+// ranked 返回按选择顺序排列的端点：当前主端点仍健康且处于最高可用优先级时排在第一位，
+// 其余按 (健康, 优先级, 健康评分) 排序，不健康的端点排在最后作为兜底
+func (p *Pool) ranked() []*endpoint {
+	type candidate struct {
+		e       *endpoint
+		healthy bool
+		score   float64
+	}
+	candidates := make([]candidate, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		candidates = append(candidates, candidate{e: e, healthy: e.healthy(p.maxHeadLag), score: e.score()})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.healthy != b.healthy {
+			return a.healthy
+		}
+		if a.e.Priority != b.e.Priority {
+			return a.e.Priority < b.e.Priority
+		}
+		return a.score < b.score
+	})
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	best := candidates[0]
+	for i, c := range candidates {
+		// 主端点与最优端点同为健康且优先级相同时保持不变，避免评分的小幅波动导致频繁切换
+		if c.e == p.primary && c.healthy && best.healthy && c.e.Priority == best.e.Priority {
+			candidates[0], candidates[i] = candidates[i], candidates[0]
+			break
+		}
+	}
+	if candidates[0].e != p.primary {
+		if p.primary != nil {
+			logx.Info(fmt.Sprintf("rpc chain %d switch primary endpoint from %s to %s", p.ChainID, p.primary.URL, candidates[0].e.URL))
+		}
+		p.primary = candidates[0].e
+	}
+
+	res := make([]*endpoint, 0, len(candidates))
+	for _, c := range candidates {
+		res = append(res, c.e)
+	}
+	return res
+}
+
+// isFailoverError 判断错误是否应切换到其他端点重试。
+// 节点正常返回的 JSON-RPC 错误（如查询区间过大、执行回滚）和未找到数据由调用方处理，不切换端点
+func isFailoverError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// call 依次在端点上执行请求，直到成功或遇到不需要切换端点的错误。
+// 被限流的端点先跳过，其他端点都失败时再等待排名最靠前的被限流端点
+func (p *Pool) call(ctx context.Context, method string, fn func(e *endpoint, c *ethclient.Client) error) error {
+	var errs []error
+	var limited []*endpoint
+	for _, e := range p.ranked() {
+		if !e.limiter.allow() {
+			limited = append(limited, e)
+			continue
+		}
+		err := p.callEndpoint(ctx, e, fn)
+		if err == nil || !isFailoverError(ctx, err) {
+			return err
+		}
+		logx.Error(fmt.Sprintf("rpc chain %d %s failed on %s, try next endpoint: %v", p.ChainID, method, e.URL, err))
+		errs = append(errs, err)
+	}
+	if len(limited) > 0 {
+		if err := limited[0].limiter.wait(ctx); err != nil {
+			return err
+		}
+		err := p.callEndpoint(ctx, limited[0], fn)
+		if err == nil || !isFailoverError(ctx, err) {
+			return err
+		}
+		errs = append(errs, err)
+	}
+	return fmt.Errorf("rpc chain %d %s: all endpoints failed: %w", p.ChainID, method, errors.Join(errs...))
+}
+
+func (p *Pool) callEndpoint(ctx context.Context, e *endpoint, fn func(e *endpoint, c *ethclient.Client) error) error {
+	c, err := e.dial(ctx)
+	if err != nil {
+		e.record(0, err)
+		return err
+	}
+	start := time.Now()
+	err = fn(e, c)
+	if err != nil && isFailoverError(ctx, err) {
+		e.record(time.Since(start), err)
+		return fmt.Errorf("%s: %w", e.URL, err)
+	}
+	e.record(time.Since(start), nil)
+	return err
+}
+
+// checkHealth 并发获取各端点的最新区块，计算落后区块数，并校验非领先端点的区块哈希是否与领先端点一致
+func (p *Pool) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	heads := make([]uint64, len(p.endpoints))
+	wg := &sync.WaitGroup{}
+	for i, e := range p.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = p.callEndpoint(ctx, e, func(e *endpoint, c *ethclient.Client) error {
+				head, err := c.BlockNumber(ctx)
+				if err == nil {
+					heads[i] = head
+				}
+				return err
+			})
+		}()
+	}
+	wg.Wait()
+
+	var leader *endpoint
+	var maxHead uint64
+	for i, e := range p.endpoints {
+		if heads[i] > maxHead || (heads[i] == maxHead && leader != nil && e.Priority < leader.Priority) {
+			leader, maxHead = e, heads[i]
+		}
+	}
+	if leader == nil {
+		logx.Error(fmt.Sprintf("rpc chain %d health check: no endpoint available", p.ChainID))
+		return
+	}
+
+	leaderHashes := make(map[uint64]ethCommon.Hash)
+	for i, e := range p.endpoints {
+		if heads[i] == 0 {
+			continue
+		}
+		agrees := true
+		if e != leader {
+			agrees = p.agreesWithLeader(ctx, e, leader, heads[i], leaderHashes)
+		}
+		wasHealthy := e.healthy(p.maxHeadLag)
+		e.mu.Lock()
+		e.head = heads[i]
+		e.headLag = maxHead - heads[i]
+		e.agrees = agrees
+		e.mu.Unlock()
+		if isHealthy := e.healthy(p.maxHeadLag); isHealthy != wasHealthy {
+			logx.Info(fmt.Sprintf("rpc chain %d endpoint healthy: %t, %s", p.ChainID, isHealthy, e.status()))
+		}
+	}
+}
+
+// agreesWithLeader 比较端点与领先端点在 number 区块上的哈希；获取失败时保持原判断
+func (p *Pool) agreesWithLeader(ctx context.Context, e, leader *endpoint, number uint64, leaderHashes map[uint64]ethCommon.Hash) bool {
+	e.mu.Lock()
+	previous := e.agrees
+	e.mu.Unlock()
+
+	leaderHash, ok := leaderHashes[number]
+	if !ok {
+		if err := p.callEndpoint(ctx, leader, func(_ *endpoint, c *ethclient.Client) error {
+			header, err := c.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+			if err == nil {
+				leaderHash = header.Hash()
+			}
+			return err
+		}); err != nil {
+			return previous
+		}
+		leaderHashes[number] = leaderHash
+	}
+
+	var hash ethCommon.Hash
+	if err := p.callEndpoint(ctx, e, func(_ *endpoint, c *ethclient.Client) error {
+		header, err := c.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err == nil {
+			hash = header.Hash()
+		}
+		return err
+	}); err != nil {
+		return previous
+	}
+	if hash != leaderHash {
+		logx.Error(fmt.Sprintf("rpc chain %d endpoint %s block %d hash %s differs from %s on %s", p.ChainID, e.URL, number, hash.Hex(), leaderHash.Hex(), leader.URL))
+		return false
+	}
+	return true
+}
+
+// BlockNumber 获取最新区块号
+func (p *Pool) BlockNumber(ctx context.Context) (uint64, error) {
+	var res uint64
+	err := p.call(ctx, "BlockNumber", func(e *endpoint, c *ethclient.Client) error {
+		var err error
+		if res, err = c.BlockNumber(ctx); err == nil {
+			e.observeHead(res)
+		}
+		return err
+	})
+	return res, err
+}
+
+func (p *Pool) HeaderByNumber(ctx context.Context, number *big.Int) (*ethereumTypes.Header, error) {
+	var res *ethereumTypes.Header
+	err := p.call(ctx, "HeaderByNumber", func(_ *endpoint, c *ethclient.Client) error {
+		var err error
+		res, err = c.HeaderByNumber(ctx, number)
+		return err
+	})
+	return res, err
+}
+
+func (p *Pool) BlockByNumber(ctx context.Context, number *big.Int) (*ethereumTypes.Block, error) {
+	var res *ethereumTypes.Block
+	err := p.call(ctx, "BlockByNumber", func(_ *endpoint, c *ethclient.Client) error {
+		var err error
+		res, err = c.BlockByNumber(ctx, number)
+		return err
+	})
+	return res, err
+}
+
+func (p *Pool) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]ethereumTypes.Log, error) {
+	var res []ethereumTypes.Log
+	err := p.call(ctx, "FilterLogs", func(_ *endpoint, c *ethclient.Client) error {
+		var err error
+		res, err = c.FilterLogs(ctx, q)
+		return err
+	})
+	return res, err
+}
+
+func (p *Pool) TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*ethereumTypes.Receipt, error) {
+	var res *ethereumTypes.Receipt
+	err := p.call(ctx, "TransactionReceipt", func(_ *endpoint, c *ethclient.Client) error {
+		var err error
+		res, err = c.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return res, err
+}
+
+func (p *Pool) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var res []byte
+	err := p.call(ctx, "CallContract", func(_ *endpoint, c *ethclient.Client) error {
+		var err error
+		res, err = c.CallContract(ctx, msg, blockNumber)
+		return err
+	})
+	return res, err
+}
+
+// subscribe 在排名最靠前的 ws/wss 端点上建立订阅
+func (p *Pool) subscribe(ctx context.Context, method string, fn func(c *ethclient.Client) (ethereum.Subscription, error)) (ethereum.Subscription, error) {
+	var errs []error
+	for _, e := range p.ranked() {
+		if !isWebsocketURL(e.URL) {
+			continue
+		}
+		var sub ethereum.Subscription
+		err := p.callEndpoint(ctx, e, func(_ *endpoint, c *ethclient.Client) error {
+			var err error
+			sub, err = fn(c)
+			return err
+		})
+		if err == nil {
+			return sub, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, fmt.Errorf("rpc chain %d %s: no websocket endpoint", p.ChainID, method)
+	}
+	return nil, fmt.Errorf("rpc chain %d %s: %w", p.ChainID, method, errors.Join(errs...))
+}
+
+func (p *Pool) SubscribeNewHead(ctx context.Context, ch chan<- *ethereumTypes.Header) (ethereum.Subscription, error) {
+	return p.subscribe(ctx, "SubscribeNewHead", func(c *ethclient.Client) (ethereum.Subscription, error) {
+		return c.SubscribeNewHead(ctx, ch)
+	})
+}
+
+func (p *Pool) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- ethereumTypes.Log) (ethereum.Subscription, error) {
+	return p.subscribe(ctx, "SubscribeFilterLogs", func(c *ethclient.Client) (ethereum.Subscription, error) {
+		return c.SubscribeFilterLogs(ctx, q, ch)
+	})
+}
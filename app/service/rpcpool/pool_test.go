@@ -0,0 +1,267 @@
+package rpcpool
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeNode 以 JSON-RPC over HTTP 响应 ethclient 请求的假节点，只实现 eth_blockNumber，其他方法返回 JSON-RPC 错误
+type fakeNode struct {
+	mu       sync.Mutex
+	head     uint64
+	status   int  // 非 0 时直接返回该 HTTP 状态码（如 500、429）
+	rpcError bool // 返回 JSON-RPC 错误（节点正常响应）
+	calls    int
+	srv      *httptest.Server
+}
+
+func newFakeNode(t *testing.T, head uint64) *fakeNode {
+	n := &fakeNode{head: head}
+	n.srv = httptest.NewServer(http.HandlerFunc(n.serve))
+	t.Cleanup(n.srv.Close)
+	return n
+}
+
+func (n *fakeNode) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage `json:"id"`
+		Method string          `json:"method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mu.Lock()
+	n.calls++
+	head, status, rpcError := n.head, n.status, n.rpcError
+	n.mu.Unlock()
+
+	if status != 0 {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if rpcError || req.Method != "eth_blockNumber" {
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"execution reverted"}}`, req.ID)
+		return
+	}
+	fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, req.ID, head)
+}
+
+func (n *fakeNode) set(status int, rpcError bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.status, n.rpcError = status, rpcError
+}
+
+// takeCalls 返回并清零收到的请求数
+func (n *fakeNode) takeCalls() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	calls := n.calls
+	n.calls = 0
+	return calls
+}
+
+func newTestPool(t *testing.T, nodes []*fakeNode, rateLimits ...int32) *Pool {
+	endpoints := make([]Endpoint, 0, len(nodes))
+	for i, n := range nodes {
+		ep := Endpoint{URL: n.srv.URL, Priority: int32(i)}
+		if i < len(rateLimits) {
+			ep.RateLimit = rateLimits[i]
+		}
+		endpoints = append(endpoints, ep)
+	}
+	p, err := New(1, endpoints, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestPoolFailover(t *testing.T) {
+	tests := []struct {
+		name      string
+		primary   func(n *fakeNode)
+		secondary func(n *fakeNode)
+		wantHead  uint64
+		wantCalls [2]int
+		checkErr  func(err error) bool
+	}{
+		{
+			name:      "primary healthy",
+			primary:   func(n *fakeNode) {},
+			secondary: func(n *fakeNode) {},
+			wantHead:  100,
+			wantCalls: [2]int{1, 0},
+		},
+		{
+			name:      "fail over on transport error",
+			primary:   func(n *fakeNode) { n.set(http.StatusInternalServerError, false) },
+			secondary: func(n *fakeNode) {},
+			wantHead:  101,
+			wantCalls: [2]int{1, 1},
+		},
+		{
+			name:      "fail over when rate limited by the node",
+			primary:   func(n *fakeNode) { n.set(http.StatusTooManyRequests, false) },
+			secondary: func(n *fakeNode) {},
+			wantHead:  101,
+			wantCalls: [2]int{1, 1},
+		},
+		{
+			// 节点正常返回的 JSON-RPC 错误由调用方处理，换一个端点结果也相同
+			name:      "no fail over on json-rpc error",
+			primary:   func(n *fakeNode) { n.set(0, true) },
+			secondary: func(n *fakeNode) {},
+			wantCalls: [2]int{1, 0},
+			checkErr: func(err error) bool {
+				var rpcErr rpc.Error
+				return errors.As(err, &rpcErr)
+			},
+		},
+		{
+			name:      "all endpoints fail",
+			primary:   func(n *fakeNode) { n.set(http.StatusBadGateway, false) },
+			secondary: func(n *fakeNode) { n.set(http.StatusInternalServerError, false) },
+			wantCalls: [2]int{1, 1},
+			checkErr: func(err error) bool {
+				return err != nil && strings.Contains(err.Error(), "all endpoints failed")
+			},
+		},
+		{
+			// 所有端点都被限流时保留 HTTP 429，同步任务据此按指数退避后重试
+			name:      "all endpoints rate limited",
+			primary:   func(n *fakeNode) { n.set(http.StatusTooManyRequests, false) },
+			secondary: func(n *fakeNode) { n.set(http.StatusTooManyRequests, false) },
+			wantCalls: [2]int{1, 1},
+			checkErr: func(err error) bool {
+				var httpErr rpc.HTTPError
+				return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, secondary := newFakeNode(t, 100), newFakeNode(t, 101)
+			tt.primary(primary)
+			tt.secondary(secondary)
+			p := newTestPool(t, []*fakeNode{primary, secondary})
+
+			head, err := p.BlockNumber(context.Background())
+			if tt.checkErr != nil {
+				if !tt.checkErr(err) {
+					t.Fatalf("BlockNumber() unexpected error: %v", err)
+				}
+			} else if err != nil || head != tt.wantHead {
+				t.Fatalf("BlockNumber() = %d, %v, want %d", head, err, tt.wantHead)
+			}
+			if got := [2]int{primary.takeCalls(), secondary.takeCalls()}; got != tt.wantCalls {
+				t.Fatalf("calls = %v, want %v", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestPoolRecovery(t *testing.T) {
+	primary, secondary := newFakeNode(t, 100), newFakeNode(t, 101)
+	p := newTestPool(t, []*fakeNode{primary, secondary})
+	ctx := context.Background()
+
+	// 主端点连续失败 failuresToCooldown 次后进入暂停期，之后的请求直接发往备用端点
+	primary.set(http.StatusInternalServerError, false)
+	for i := 0; i < failuresToCooldown; i++ {
+		if head, err := p.BlockNumber(ctx); err != nil || head != 101 {
+			t.Fatalf("BlockNumber() #%d = %d, %v, want 101", i, head, err)
+		}
+	}
+	primary.set(0, false)
+	primary.takeCalls()
+	if head, err := p.BlockNumber(ctx); err != nil || head != 101 {
+		t.Fatalf("BlockNumber() during cooldown = %d, %v, want 101", head, err)
+	}
+	if calls := primary.takeCalls(); calls != 0 {
+		t.Fatalf("primary received %d calls during cooldown, want 0", calls)
+	}
+
+	// 暂停期结束后优先级更高的端点重新成为主端点
+	p.endpoints[0].mu.Lock()
+	p.endpoints[0].downUntil = time.Now().Add(-time.Second)
+	p.endpoints[0].mu.Unlock()
+	if head, err := p.BlockNumber(ctx); err != nil || head != 100 {
+		t.Fatalf("BlockNumber() after cooldown = %d, %v, want 100", head, err)
+	}
+	if p.primary != p.endpoints[0] {
+		t.Fatalf("primary = %s, want %s", p.primary.URL, p.endpoints[0].URL)
+	}
+}
+
+func TestPoolHealthCheck(t *testing.T) {
+	// 优先级更高的端点落后超过 max_head_lag，不再被选为主端点
+	lagging, leader := newFakeNode(t, 100-defaultMaxHeadLag-1), newFakeNode(t, 100)
+	p := newTestPool(t, []*fakeNode{lagging, leader})
+	ctx := context.Background()
+
+	p.checkHealth(ctx)
+	if p.endpoints[0].healthy(p.maxHeadLag) {
+		t.Fatalf("lagging endpoint should be unhealthy: %s", p.endpoints[0].status())
+	}
+	if head, err := p.BlockNumber(ctx); err != nil || head != 100 {
+		t.Fatalf("BlockNumber() = %d, %v, want 100", head, err)
+	}
+
+	// 追上后恢复为主端点
+	lagging.mu.Lock()
+	lagging.head = 100
+	lagging.mu.Unlock()
+	p.checkHealth(ctx)
+	if !p.endpoints[0].healthy(p.maxHeadLag) {
+		t.Fatalf("endpoint should be healthy again: %s", p.endpoints[0].status())
+	}
+	lagging.takeCalls()
+	if _, err := p.BlockNumber(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if calls := lagging.takeCalls(); calls != 1 {
+		t.Fatalf("recovered endpoint received %d calls, want 1", calls)
+	}
+}
+
+func TestPoolRateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	// 本地限流的端点没有令牌时先使用其他端点
+	limited, other := newFakeNode(t, 100), newFakeNode(t, 101)
+	p := newTestPool(t, []*fakeNode{limited, other}, 1)
+	for _, want := range []uint64{100, 101} {
+		if head, err := p.BlockNumber(ctx); err != nil || head != want {
+			t.Fatalf("BlockNumber() = %d, %v, want %d", head, err, want)
+		}
+	}
+
+	// 只有被限流的端点时等待令牌，ctx 先结束则返回 ctx 的错误
+	only := newFakeNode(t, 100)
+	p = newTestPool(t, []*fakeNode{only}, 1)
+	if _, err := p.BlockNumber(ctx); err != nil {
+		t.Fatal(err)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := p.BlockNumber(waitCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("BlockNumber() error = %v, want deadline exceeded", err)
+	}
+	if calls := only.takeCalls(); calls != 1 {
+		t.Fatalf("rate limited endpoint received %d calls, want 1", calls)
+	}
+}
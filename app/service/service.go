@@ -2,14 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dijiacoder/MetaNodeStakeSync/app/service/common"
 	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
-	"github.com/dijiacoder/MetaNodeStakeSync/app/service/stake"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/indexer"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/outbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/rpcpool"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/webhook"
 	"github.com/dijiacoder/MetaNodeStakeSync/dao/query"
 	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/chaincontract"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-redis/redis/v8"
 	"github.com/zeromicro/go-zero/core/logx"
 	"gorm.io/driver/mysql"
@@ -20,59 +24,169 @@ type Service struct {
 	serviceCtx *common.ServiceContext
 }
 
-func New(ctx context.Context, config *config.Config) (*Service, error) {
+// NewDB 连接数据库并初始化 gen 默认查询对象
+func NewDB(config *config.Config) (*gorm.DB, error) {
 	logx.Info(fmt.Sprintf("DB connection string: %s", config.DB.DSN))
 	db, err := gorm.Open(mysql.Open(config.DB.DSN), &gorm.Config{})
-	db = db.Debug()
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	db = db.Debug()
 	query.SetDefault(db)
+	return db, nil
+}
 
-	redisClient := redis.NewClient(&redis.Options{
+// NewRedis 创建 Redis 客户端，连接在首次使用时建立
+func NewRedis(config *config.Config) *redis.Client {
+	return redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%d", config.Redis.Host, config.Redis.Port),
 		Password: config.Redis.Password,
 		DB:       config.Redis.DB,
 	})
+}
+
+// New 连接数据库、执行表结构迁移并加载 chain_contracts 中的合约和端点，任一步骤失败时返回错误
+func New(ctx context.Context, config *config.Config) (*Service, error) {
+	db, err := NewDB(config)
+	if err != nil {
+		return nil, fmt.Errorf("New: connect db error: %w", err)
+	}
+
+	// 执行已注册模块的表结构迁移
+	if err := indexer.Migrate(ctx, db); err != nil {
+		return nil, err
+	}
+
+	redisClient := NewRedis(config)
+
+	var sink outbox.Sink
+	if config.Outbox != nil && config.Outbox.Enabled {
+		if sink, err = outbox.NewSink(config.Outbox, redisClient); err != nil {
+			return nil, err
+		}
+		logx.Info(fmt.Sprintf("event outbox enabled, sink: %s", sink.Name()))
+	}
+
+	var webhooks *webhook.Sender
+	if config.Webhook != nil && config.Webhook.Enabled {
+		webhooks = webhook.NewSender(config.Webhook)
+		logx.Info("webhook delivery enabled")
+	}
 
 	contracts, err := chaincontract.GetContractWithEndPoint()
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("New: load contracts error: %w", err)
 	}
 
-	contractInfoMap := make(map[int32]*common.ContractInfo)
+	contractInfos := make([]*common.ContractInfo, 0, len(contracts))
+	// 同一条链上的合约共用一个端点池
+	pools := make(map[int32]*rpcpool.Pool)
 
 	for _, contract := range contracts {
-		logx.Info(fmt.Sprintf("ContractName: %d, ChainID: %d, CreatedTxHash: %s, ContractAddress: %s, ChainEndpointURL: %s", contract.ChainContract.ContractName,
-			contract.ChainContract.ChainID, *contract.ChainContract.CreatedTxHash, contract.ChainContract.ContractAddress, contract.ChainEndpoint.URL))
-		ethClient, err := ethclient.Dial(contract.ChainEndpoint.URL)
-		if err != nil {
-			panic(err)
+		// 同步起点由创建交易所在的区块决定
+		if contract.ChainContract.CreatedTxHash == nil || *contract.ChainContract.CreatedTxHash == "" {
+			logx.Error(fmt.Sprintf("skip contract %s: created_tx_hash is not set", contract.ChainContract.ContractAddress))
+			continue
+		}
+		logx.Info(fmt.Sprintf("ContractName: %d, ChainID: %d, CreatedTxHash: %s, ContractAddress: %s, ChainEndpoints: %d", contract.ChainContract.ContractName,
+			contract.ChainContract.ChainID, *contract.ChainContract.CreatedTxHash, contract.ChainContract.ContractAddress, len(contract.ChainEndpoints)))
+		pool, ok := pools[contract.ChainContract.ChainID]
+		if !ok {
+			endpoints := make([]rpcpool.Endpoint, 0, len(contract.ChainEndpoints))
+			for _, ep := range contract.ChainEndpoints {
+				endpoints = append(endpoints, rpcpool.Endpoint{URL: ep.URL, Priority: ep.Priority, RateLimit: ep.RateLimit})
+			}
+			if pool, err = rpcpool.New(contract.ChainContract.ChainID, endpoints, config.RPC); err != nil {
+				logx.Error(fmt.Sprintf("skip contract %s: %v", contract.ChainContract.ContractAddress, err))
+				continue
+			}
+			pool.Start(ctx)
+			pools[contract.ChainContract.ChainID] = pool
 		}
 
 		contractInfo := &common.ContractInfo{
-			ChainID:     contract.ChainContract.ChainID,
-			ABIStr:      contract.ChainContract.Abi,
-			Address:     contract.ChainContract.ContractAddress,
-			CreatedHash: contract.ChainContract.CreatedTxHash,
-			Client:      ethClient,
+			ChainID:       contract.ChainContract.ChainID,
+			ContractName:  contract.ChainContract.ContractName,
+			ABIStr:        contract.ChainContract.Abi,
+			Address:       contract.ChainContract.ContractAddress,
+			CreatedHash:   contract.ChainContract.CreatedTxHash,
+			SyncMode:      contract.ChainContract.SyncMode,
+			Confirmations: uint64(contract.ChainContract.Confirmations),
+			Client:        pool,
 		}
-		contractInfoMap[contract.ChainContract.ContractName] = contractInfo
+		contractInfos = append(contractInfos, contractInfo)
 	}
 
 	service := &Service{
 		serviceCtx: &common.ServiceContext{
-			Context:         ctx,
-			Config:          config,
-			DB:              db,
-			RedisClient:     redisClient,
-			ContractInfoMap: contractInfoMap,
+			Context:     ctx,
+			Config:      config,
+			DB:          db,
+			RedisClient: redisClient,
+			Sink:        sink,
+			Webhooks:    webhooks,
+			Contracts:   contractInfos,
 		},
 	}
 	return service, nil
 }
 
-func (service *Service) Start() {
-	//stake contract name: 1
-	stake.NewTaskStake(service.serviceCtx).Start()
+// Start 为 chain_contracts 中的每个合约启动一个同步任务，事件由 contract_name 对应的已注册模块处理，
+// 各任务使用独立的同步进度和RPC客户端，任务异常退出时自动重启。返回已启动的任务数量，没有可启动的任务时返回错误
+func (service *Service) Start() (int, error) {
+	started := 0
+	var errs []error
+	for _, contract := range service.serviceCtx.Contracts {
+		task, err := indexer.NewTask(service.serviceCtx, contract)
+		if err != nil {
+			errs = append(errs, err)
+			logx.Error(fmt.Sprintf("create sync task error: %v", err))
+			continue
+		}
+		logx.Info(fmt.Sprintf("start sync task %s", task.Name()))
+		task.Start()
+		started++
+	}
+	if started == 0 {
+		return 0, fmt.Errorf("Start: no sync task started: %w", errors.Join(errs...))
+	}
+	return started, nil
+}
+
+// DB 返回服务使用的数据库连接
+func (service *Service) DB() *gorm.DB {
+	return service.serviceCtx.DB
+}
+
+// Contracts 返回所有已注册的合约
+func (service *Service) Contracts() []*common.ContractInfo {
+	return service.serviceCtx.Contracts
+}
+
+// newTask 为指定链上的合约创建同步任务
+func (service *Service) newTask(chainID int32, address string) (*indexer.Task, error) {
+	for _, contract := range service.serviceCtx.Contracts {
+		if contract.ChainID == chainID && strings.EqualFold(contract.Address, address) {
+			return indexer.NewTask(service.serviceCtx, contract)
+		}
+	}
+	return nil, fmt.Errorf("contract %s on chain %d not found", address, chainID)
+}
+
+// Backfill 并发回填指定合约 [from, to] 区间的历史事件，to 为 0 时回填到当前可同步的最高区块
+func (service *Service) Backfill(ctx context.Context, chainID int32, address string, from, to, chunkSize uint64, workers int) error {
+	task, err := service.newTask(chainID, address)
+	if err != nil {
+		return fmt.Errorf("Backfill: %w", err)
+	}
+	return task.Backfill(ctx, from, to, chunkSize, workers)
+}
+
+// Reindex 回滚并重新处理指定合约从 from 到同步进度的已同步区块
+func (service *Service) Reindex(ctx context.Context, chainID int32, address string, from, chunkSize uint64, workers int) error {
+	task, err := service.newTask(chainID, address)
+	if err != nil {
+		return fmt.Errorf("Reindex: %w", err)
+	}
+	return task.Reindex(ctx, from, chunkSize, workers)
 }
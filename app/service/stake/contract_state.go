@@ -0,0 +1,187 @@
+package stake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractstate"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	"github.com/ethereum/go-ethereum"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// PrepareRange 处理区间前初始化 stake_contract_state，使参数历史总是从部署区块开始，与区间内是否有参数变更事件无关
+func (t *TaskStake) PrepareRange(ctx context.Context) error {
+	if _, err := t.ensureContractState(ctx); err != nil {
+		return fmt.Errorf("ensureContractState error: %w", err)
+	}
+	return nil
+}
+
+// ensureContractState 确保 stake_contract_state 中存在当前合约的记录。
+// initialize 设置 startBlock/endBlock/MetaNodePerBlock 时不会触发事件，因此处理第一个区间时通过 eth_call 读取部署区块的合约参数和暂停状态，
+// 之后的变更由事件维护。节点不保留部署区块的状态（非归档节点）时改为读取最新区块，该区块及之前的事件不再修改参数，
+// 参数历史从该区块开始
+func (t *TaskStake) ensureContractState(ctx context.Context) (*model.StakeContractState, error) {
+	state, err := contractstate.GetByContract(ctx, t.DB, t.ChainID, t.Address)
+	if err == nil {
+		return state, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	seedBlock, err := t.DeployBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state, err = t.readContractState(ctx, seedBlock)
+	if isStateUnavailableError(err) {
+		logx.Error(fmt.Sprintf("%s: node has no state at deployment block %d (%v), it is not an archive node; "+
+			"falling back to the latest block, contract parameters before it are unavailable", t.Name(), seedBlock, err))
+		header, headerErr := t.Client.HeaderByNumber(ctx, nil)
+		if headerErr != nil {
+			return nil, fmt.Errorf("ensureContractState: get latest header error: %w", headerErr)
+		}
+		seedBlock = header.Number.Uint64()
+		state, err = t.readContractState(ctx, seedBlock)
+		if err != nil {
+			return nil, fmt.Errorf("ensureContractState: read contract state at latest block %d error: %w", seedBlock, err)
+		}
+	} else if err != nil {
+		return nil, fmt.Errorf("ensureContractState: read contract state at deployment block %d error: %w", seedBlock, err)
+	}
+
+	state.LastUpdatedBlock = &seedBlock
+	if err := contractstate.Create(ctx, t.DB, state); err != nil {
+		return nil, err
+	}
+	if err := contractstate.SaveHistory(ctx, t.DB, seedBlock, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// updateContractState 在 blockNumber 区块的参数变更事件中更新 stake_contract_state，并记录变更后的完整参数。
+// 早于初始化读取区块的事件已包含在读取的参数中，直接跳过
+func (t *TaskStake) updateContractState(ctx context.Context, blockNumber uint64, updates map[string]interface{}) error {
+	state, err := t.ensureContractState(ctx)
+	if err != nil {
+		return fmt.Errorf("ensureContractState error: %w", err)
+	}
+	if state.LastUpdatedBlock != nil && blockNumber < *state.LastUpdatedBlock {
+		return nil
+	}
+
+	updates["last_updated_block"] = blockNumber
+	if err := contractstate.UpdateByContract(ctx, t.DB, t.ChainID, t.Address, updates); err != nil {
+		return fmt.Errorf("update stake_contract_state error: %w", err)
+	}
+	if state, err = contractstate.GetByContract(ctx, t.DB, t.ChainID, t.Address); err != nil {
+		return fmt.Errorf("get stake_contract_state error: %w", err)
+	}
+	if err := contractstate.SaveHistory(ctx, t.DB, blockNumber, state); err != nil {
+		return fmt.Errorf("save stake_contract_state_history error: %w", err)
+	}
+	return nil
+}
+
+// readContractState 通过 eth_call 读取 blockNumber 时的合约参数和暂停状态
+func (t *TaskStake) readContractState(ctx context.Context, blockNumber uint64) (*model.StakeContractState, error) {
+	at := new(big.Int).SetUint64(blockNumber)
+	metaNode, err := t.callContract(ctx, at, "MetaNode")
+	if err != nil {
+		return nil, err
+	}
+	startBlock, err := t.callContract(ctx, at, "startBlock")
+	if err != nil {
+		return nil, err
+	}
+	endBlock, err := t.callContract(ctx, at, "endBlock")
+	if err != nil {
+		return nil, err
+	}
+	metaNodePerBlock, err := t.callContract(ctx, at, "MetaNodePerBlock")
+	if err != nil {
+		return nil, err
+	}
+	withdrawPaused, err := t.callContract(ctx, at, "withdrawPaused")
+	if err != nil {
+		return nil, err
+	}
+	claimPaused, err := t.callContract(ctx, at, "claimPaused")
+	if err != nil {
+		return nil, err
+	}
+	paused, err := t.callContract(ctx, at, "paused")
+	if err != nil {
+		return nil, err
+	}
+
+	token := metaNode.(ethCommon.Address).Hex()
+	start := startBlock.(*big.Int).Uint64()
+	end := endBlock.(*big.Int).Uint64()
+	perBlock := types.NewBigInt(metaNodePerBlock.(*big.Int))
+	return &model.StakeContractState{
+		ChainID:          t.ChainID,
+		ContractAddress:  t.Address,
+		MetanodeToken:    &token,
+		StartBlock:       &start,
+		EndBlock:         &end,
+		MetanodePerBlock: &perBlock,
+		WithdrawPaused:   withdrawPaused.(bool),
+		ClaimPaused:      claimPaused.(bool),
+		Paused:           paused.(bool),
+	}, nil
+}
+
+// stateUnavailableErrors 非归档节点查询已裁剪的历史状态时各客户端返回的错误信息
+var stateUnavailableErrors = []string{
+	"missing trie node",
+	"header not found",
+	"historical state",
+	"state not available",
+	"state is not available",
+	"pruned",
+}
+
+// isStateUnavailableError 判断 eth_call 是否因节点不保留该区块的状态而失败
+func isStateUnavailableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, s := range stateUnavailableErrors {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// callContract 在指定区块调用合约的无参view方法，返回第一个返回值
+func (t *TaskStake) callContract(ctx context.Context, blockNumber *big.Int, method string) (interface{}, error) {
+	data, err := t.ABI.Pack(method)
+	if err != nil {
+		return nil, err
+	}
+	to := ethCommon.HexToAddress(t.Address)
+	out, err := t.Client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("call %s error: %w", method, err)
+	}
+	values, err := t.ABI.Unpack(method, out)
+	if err != nil {
+		return nil, fmt.Errorf("unpack %s error: %w", method, err)
+	}
+	if len(values) < 1 {
+		return nil, fmt.Errorf("call %s: empty result", method)
+	}
+	return values[0], nil
+}
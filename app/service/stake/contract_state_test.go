@@ -0,0 +1,150 @@
+package stake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestIsStateUnavailableError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("call MetaNode error: missing trie node 1a2b3c (path )"), true},
+		{errors.New("call startBlock error: header not found"), true},
+		{errors.New("historical state 0xabc is not available"), true},
+		{errors.New("state not available for block 100"), true},
+		{errors.New("required historical state unavailable (reexec=128)"), true},
+		{errors.New("block 100 has been pruned"), true},
+		{errors.New("execution reverted"), false},
+		{errors.New("context deadline exceeded"), false},
+	}
+	for _, tt := range tests {
+		if got := isStateUnavailableError(tt.err); got != tt.want {
+			t.Fatalf("isStateUnavailableError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestEnsureContractState(t *testing.T) {
+	token := ethCommon.HexToAddress("0x3333333333333333333333333333333333333333")
+	state := map[string]interface{}{
+		"MetaNode":         token,
+		"startBlock":       big.NewInt(30),
+		"endBlock":         big.NewInt(1000),
+		"MetaNodePerBlock": big.NewInt(5),
+		"withdrawPaused":   false,
+		"claimPaused":      true,
+		"paused":           false,
+	}
+	tests := []struct {
+		name         string
+		prunedBefore uint64
+		wantSeed     uint64 // 初始化读取参数的区块
+		wantHistory  []uint64
+		wantEndBlock uint64
+	}{
+		// 归档节点读取部署区块的参数，之后的事件按区块更新
+		{"archive node", 0, 20, []uint64{20, 120}, 2000},
+		// 非归档节点改为读取最新区块 150，之前的事件已包含在读取的参数中
+		{"pruned node", 100, 150, []uint64{150}, 1000},
+	}
+	for _, tt := range tests {
+		task := newTestTaskStakeWithNode(t, &testNode{deployBlock: 20, latest: 150, prunedBefore: tt.prunedBefore, state: state})
+		if err := task.HandleSetEndBlockEvent(eventLog(t, task, "SetEndBlock", 120, "0x01", 0, big.NewInt(2000))); err != nil {
+			t.Fatalf("%s: HandleSetEndBlockEvent() error: %v", tt.name, err)
+		}
+
+		// 事件总是写入事件表
+		var count int64
+		if err := task.DB.Model(&model.EventSetEndBlock{}).Count(&count).Error; err != nil || count != 1 {
+			t.Fatalf("%s: event_set_end_block = %d, %v, want 1", tt.name, count, err)
+		}
+		history := contractStateHistory(t, task)
+		var blocks []uint64
+		for _, h := range history {
+			blocks = append(blocks, h.BlockNumber)
+		}
+		if fmt.Sprint(blocks) != fmt.Sprint(tt.wantHistory) {
+			t.Fatalf("%s: stake_contract_state_history blocks = %v, want %v", tt.name, blocks, tt.wantHistory)
+		}
+		if seed := historyParams(history[0]); seed != token.Hex()+" 30 1000 5 false true false" {
+			t.Fatalf("%s: seeded params = %s", tt.name, seed)
+		}
+		checkContractState(t, task, tt.wantHistory[len(tt.wantHistory)-1], fmt.Sprintf("%s 30 %d 5 false true false", token.Hex(), tt.wantEndBlock))
+	}
+}
+
+func TestUpdateContractState(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 50)
+	zero := ethCommon.Address{}.Hex()
+
+	// 同一区块内的多次变更只保留一条历史，记录最后的参数
+	if err := task.HandleSetStartBlockEvent(eventLog(t, task, "SetStartBlock", 100, "0x01", 0, big.NewInt(200))); err != nil {
+		t.Fatal(err)
+	}
+	if err := task.HandleSetEndBlockEvent(eventLog(t, task, "SetEndBlock", 100, "0x01", 1, big.NewInt(2000))); err != nil {
+		t.Fatal(err)
+	}
+	checkContractState(t, task, 100, zero+" 200 2000 5 false false false")
+	if n := len(contractStateHistory(t, task)); n != 1 {
+		t.Fatalf("stake_contract_state_history = %d rows, want 1", n)
+	}
+
+	// 早于最后变更区块的事件只写入事件表，不回退参数
+	if err := task.HandleSetEndBlockEvent(eventLog(t, task, "SetEndBlock", 40, "0x02", 0, big.NewInt(500))); err != nil {
+		t.Fatal(err)
+	}
+	checkContractState(t, task, 100, zero+" 200 2000 5 false false false")
+	var count int64
+	if err := task.DB.Model(&model.EventSetEndBlock{}).Count(&count).Error; err != nil || count != 2 {
+		t.Fatalf("event_set_end_block = %d, %v, want 2", count, err)
+	}
+	if n := len(contractStateHistory(t, task)); n != 1 {
+		t.Fatalf("stake_contract_state_history = %d rows, want 1", n)
+	}
+}
+
+func TestPrepareRange(t *testing.T) {
+	token := ethCommon.HexToAddress("0x3333333333333333333333333333333333333333")
+	state := map[string]interface{}{
+		"MetaNode":         token,
+		"startBlock":       big.NewInt(30),
+		"endBlock":         big.NewInt(1000),
+		"MetaNodePerBlock": big.NewInt(5),
+		"withdrawPaused":   false,
+		"claimPaused":      false,
+		"paused":           false,
+	}
+	task := newTestTaskStakeWithNode(t, &testNode{deployBlock: 20, latest: 150, state: state})
+
+	// 区间内没有参数变更事件时也从部署区块初始化，重复调用不再写入历史
+	for i := 0; i < 2; i++ {
+		if err := task.PrepareRange(context.Background()); err != nil {
+			t.Fatalf("PrepareRange() error: %v", err)
+		}
+	}
+	history := contractStateHistory(t, task)
+	if len(history) != 1 || history[0].BlockNumber != 20 {
+		t.Fatalf("stake_contract_state_history = %d rows, want 1 row at deployment block 20", len(history))
+	}
+	checkContractState(t, task, 20, token.Hex()+" 30 1000 5 false false false")
+
+	// 之后的参数变更事件在初始化的参数上追加历史
+	if err := task.HandleSetEndBlockEvent(eventLog(t, task, "SetEndBlock", 120, "0x01", 0, big.NewInt(2000))); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(contractStateHistory(t, task)); n != 2 {
+		t.Fatalf("stake_contract_state_history = %d rows, want 2", n)
+	}
+	checkContractState(t, task, 120, token.Hex()+" 30 2000 5 false false false")
+}
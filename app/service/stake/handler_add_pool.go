@@ -8,6 +8,7 @@ import (
 
 	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
 	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
 	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -21,7 +22,7 @@ func (t *TaskStake) HandleAddPoolEvent(l ethereumTypes.Log) error {
 	defer cancel()
 
 	// 先查重：若已按创建交易写入过pool_info，则跳过
-	exists, err := poolinfo.ExistsByCreatedTx(ctx, t.DB, l.TxHash.Hex())
+	exists, err := poolinfo.ExistsByCreatedTx(ctx, t.DB, t.ChainID, l.TxHash.Hex())
 	if err != nil {
 		return fmt.Errorf("HandleAddPoolEvent: ExistsByCreatedTx error: %w", err)
 	}
@@ -46,36 +47,31 @@ func (t *TaskStake) HandleAddPoolEvent(l ethereumTypes.Log) error {
 	unstakeLockedBlocks := params[1].(*big.Int) // 解锁区块数
 
 	// 获取区块时间
-	block, err := t.Client.BlockByNumber(ctx, big.NewInt(int64(l.BlockNumber)))
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
 	if err != nil {
 		return fmt.Errorf("HandleAddPoolEvent: get block error: %w", err)
 	}
 
 	// 计算下一个PoolID（同一合约地址下：如果当前是0条，则NextPoolID=0）
-	poolID, err := poolinfo.GetNextPoolID(ctx, t.DB, t.Address)
+	poolID, err := poolinfo.GetNextPoolID(ctx, t.DB, t.ChainID, t.Address)
 	if err != nil {
 		return fmt.Errorf("HandleAddPoolEvent: GetNextPoolID error: %w", err)
 	}
 
-	// 类型转换
-	poolWeightFloat, _ := new(big.Float).SetInt(poolWeight).Float64()
-	minDepositAmountFloat, _ := new(big.Float).
-		Quo(new(big.Float).SetInt(minDepositAmount), big.NewFloat(1e18)).
-		Float64()
-
 	isActive := true
 	createdBlock := l.BlockNumber
 	createdTx := l.TxHash.Hex()
-	createdAt := time.Unix(int64(block.Time()), 0)
+	createdAt := time.Unix(int64(blockTimestamp), 0)
 
 	// 构建并写入 pool_info
 	item := &model.PoolInfo{
+		ChainID:             t.ChainID,
 		PoolID:              poolID,
 		ContractAddress:     t.Address,
 		StTokenAddress:      stTokenAddress,
-		PoolWeight:          poolWeightFloat,
+		PoolWeight:          types.NewBigInt(poolWeight),
 		LastRewardBlock:     lastRewardBlock.Uint64(),
-		MinDepositAmount:    minDepositAmountFloat,
+		MinDepositAmount:    types.NewBigInt(minDepositAmount),
 		UnstakeLockedBlocks: int32(unstakeLockedBlocks.Int64()),
 		IsActive:            &isActive,
 		CreatedBlock:        &createdBlock,
@@ -87,5 +83,16 @@ func (t *TaskStake) HandleAddPoolEvent(l ethereumTypes.Log) error {
 		return fmt.Errorf("HandleAddPoolEvent: create pool_info error: %w", err)
 	}
 
+	// 与 MetaNodeStake.addPool 一致：totalPoolWeight += poolWeight（合约未在事件中给出总权重）
+	totalPoolWeight, err := poolinfo.SumPoolWeightByContract(ctx, t.DB, t.ChainID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleAddPoolEvent: SumPoolWeightByContract error: %w", err)
+	}
+	if err := poolinfo.UpdateByContract(ctx, t.DB, t.ChainID, t.Address, map[string]interface{}{
+		"total_pool_weight": totalPoolWeight,
+	}); err != nil {
+		return fmt.Errorf("HandleAddPoolEvent: update total_pool_weight error: %w", err)
+	}
+
 	return nil
 }
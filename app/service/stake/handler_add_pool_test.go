@@ -0,0 +1,60 @@
+package stake
+
+import (
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleAddPoolEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	stToken := ethCommon.HexToAddress("0x3333333333333333333333333333333333333333")
+
+	// 资金池ID按添加顺序从 0 开始分配，每次添加后同一合约下所有池的总权重为各池权重之和
+	adds := []struct {
+		block        uint64
+		txHash       string
+		weight       int64
+		minDeposit   int64
+		lockedBlocks int64
+	}{
+		{50, "0x01", 100, 10, 20},
+		{60, "0x02", 300, 0, 5},
+	}
+	for _, a := range adds {
+		l := eventLog(t, task, "AddPool", a.block, a.txHash, 0, stToken, big.NewInt(a.weight), big.NewInt(int64(a.block)), big.NewInt(a.minDeposit), big.NewInt(a.lockedBlocks))
+		if err := task.HandleAddPoolEvent(l); err != nil {
+			t.Fatalf("HandleAddPoolEvent(%s) error: %v", a.txHash, err)
+		}
+	}
+	for i, a := range adds {
+		pool := getPool(t, task, int32(i))
+		if pool.PoolWeight.String() != big.NewInt(a.weight).String() || pool.LastRewardBlock != a.block ||
+			pool.MinDepositAmount.String() != big.NewInt(a.minDeposit).String() || pool.UnstakeLockedBlocks != int32(a.lockedBlocks) {
+			t.Fatalf("pool %d = %+v", i, pool)
+		}
+		if pool.TotalPoolWeight == nil || pool.TotalPoolWeight.String() != "400" {
+			t.Fatalf("pool %d total_pool_weight = %v, want 400", i, pool.TotalPoolWeight)
+		}
+		if pool.CreatedBlock == nil || *pool.CreatedBlock != a.block || pool.CreatedTx == nil || *pool.CreatedTx != ethCommon.HexToHash(a.txHash).Hex() {
+			t.Fatalf("pool %d created block/tx = %v/%v", i, pool.CreatedBlock, pool.CreatedTx)
+		}
+		// 创建时间取自区块时间戳
+		if pool.CreatedAt == nil || pool.CreatedAt.Unix() != int64(testTimestamp+a.block) {
+			t.Fatalf("pool %d created_at = %v, want %d", i, pool.CreatedAt, testTimestamp+a.block)
+		}
+	}
+
+	// 同一创建交易重复处理时跳过，不会分配新的资金池ID
+	l := eventLog(t, task, "AddPool", 50, "0x01", 0, stToken, big.NewInt(100), big.NewInt(50), big.NewInt(10), big.NewInt(20))
+	if err := task.HandleAddPoolEvent(l); err != nil {
+		t.Fatal(err)
+	}
+	var count int64
+	if err := task.DB.Model(&model.PoolInfo{}).Count(&count).Error; err != nil || count != 2 {
+		t.Fatalf("pool_info = %d, %v, want 2", count, err)
+	}
+}
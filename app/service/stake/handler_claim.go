@@ -0,0 +1,93 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func (t *TaskStake) HandleClaimEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + user、poolId两个indexed参数）
+	if len(l.Topics) < 3 {
+		return fmt.Errorf("HandleClaimEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	userAddress := topicToAddress(l.Topics[1])
+	poolID := int32(l.Topics[2].Big().Int64())
+
+	// 解析非indexed参数（data中）
+	params, err := t.ABI.Events["Claim"].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("HandleClaimEvent: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("HandleClaimEvent: invalid params length")
+	}
+	rewardWei := params[0].(*big.Int) // 领取的MetaNode奖励
+	metaNodeReward := types.NewBigInt(rewardWei)
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleClaimEvent: get block error: %w", err)
+	}
+
+	// 写入 event_claim
+	if err := stakeevents.CreateClaim(ctx, t.DB, &model.EventClaim{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		UserAddress:     userAddress,
+		PoolID:          poolID,
+		MetanodeReward:  metaNodeReward,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleClaimEvent: create event_claim error: %w", err)
+	}
+
+	// 与 MetaNodeStake.claim 一致：有奖励发放时清零 pendingMetaNode，并按当前 accMetaNodePerST 更新 finishedMetaNode
+	pool, err := poolinfo.GetByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleClaimEvent: get pool_info error: %w", err)
+	}
+	stats, err := userpoolstats.FirstOrCreate(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleClaimEvent: FirstOrCreate user_pool_stats error: %w", err)
+	}
+	userState, claimed, err := reward.Claim(reward.PoolFromModel(pool), reward.UserFromModel(stats))
+	if err != nil {
+		return fmt.Errorf("HandleClaimEvent: reward.Claim error: %w", err)
+	}
+	if claimed.Cmp(rewardWei) != 0 {
+		logx.Errorf("HandleClaimEvent: reward mismatch, user=%s, pool=%d, event=%s, computed=%s, tx=%s",
+			userAddress, poolID, rewardWei.String(), claimed.String(), l.TxHash.Hex())
+	}
+
+	// 更新 user_pool_stats：奖励状态、累计领取、最后领取区块
+	if err := userpoolstats.UpdateByUserPoolAndContract(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address, map[string]interface{}{
+		"finished_metanode": types.NewBigInt(userState.FinishedMetaNode),
+		"pending_metanode":  types.NewBigInt(userState.PendingMetaNode),
+		"settled_metanode":  types.NewBigInt(userState.PendingMetaNode),
+		"total_claimed":     types.IncrExpr("total_claimed", metaNodeReward),
+		"last_claim_block":  l.BlockNumber,
+	}); err != nil {
+		return fmt.Errorf("HandleClaimEvent: update user_pool_stats error: %w", err)
+	}
+
+	return nil
+}
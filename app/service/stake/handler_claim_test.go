@@ -0,0 +1,69 @@
+package stake
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleClaimEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedPool(t, task, 0, "100", "3000000000000000000", "400", 10)
+	seedStats(t, task, 0, "400", "800", "850")
+
+	tests := []struct {
+		name        string
+		block       uint64
+		txHash      string
+		reward      int64
+		wantStats   [3]string // finished_metanode, pending_metanode, total_claimed
+		wantClaimed string
+	}{
+		// 领取已结算的 850 和新累计的 400*3-800=400，pendingMetaNode 清零，finishedMetaNode 按当前 accMetaNodePerST 重置
+		{"claim", 120, "0x01", 1250, [3]string{"1200", "0", "1250"}, "1250"},
+		// 没有新奖励时合约发放 0，只更新最后领取区块
+		{"nothing to claim", 125, "0x02", 0, [3]string{"1200", "0", "1250"}, "0"},
+	}
+	for _, tt := range tests {
+		if err := task.HandleClaimEvent(eventLog(t, task, "Claim", tt.block, tt.txHash, 4, testUser, big.NewInt(0), big.NewInt(tt.reward))); err != nil {
+			t.Fatalf("%s: HandleClaimEvent() error: %v", tt.name, err)
+		}
+
+		var ev model.EventClaim
+		if err := task.DB.Where("block_number = ?", tt.block).First(&ev).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ev.UserAddress != testUser.Hex() || ev.PoolID != 0 || ev.MetanodeReward.String() != tt.wantClaimed ||
+			ev.BlockTimestamp != testTimestamp+tt.block || ev.LogIndex != 4 {
+			t.Fatalf("%s: event_claim = %+v", tt.name, ev)
+		}
+		stats := getStats(t, task, 0)
+		got := [3]string{stats.FinishedMetanode.String(), stats.PendingMetanode.String(), stats.TotalClaimed.String()}
+		if got != tt.wantStats {
+			t.Fatalf("%s: user_pool_stats = %v, want %v", tt.name, got, tt.wantStats)
+		}
+		if stats.LastClaimBlock == nil || *stats.LastClaimBlock != tt.block {
+			t.Fatalf("%s: last_claim_block = %v, want %d", tt.name, stats.LastClaimBlock, tt.block)
+		}
+		// 领取不改变质押数量
+		if stats.StAmount.String() != "400" {
+			t.Fatalf("%s: st_amount = %s, want 400", tt.name, stats.StAmount)
+		}
+	}
+}
+
+func TestHandleClaimEventMismatch(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedPool(t, task, 0, "100", "3000000000000000000", "400", 10)
+	seedStats(t, task, 0, "400", "800", "850")
+
+	// 链下计算结果（1250）与事件不一致时只记录错误日志，累计领取以链上事件为准
+	if err := task.HandleClaimEvent(eventLog(t, task, "Claim", 120, "0x01", 0, testUser, big.NewInt(0), big.NewInt(1000))); err != nil {
+		t.Fatal(err)
+	}
+	stats := getStats(t, task, 0)
+	if stats.TotalClaimed.String() != "1000" || stats.PendingMetanode.String() != "0" {
+		t.Fatalf("total_claimed = %s, pending_metanode = %s, want 1000, 0", stats.TotalClaimed, stats.PendingMetanode)
+	}
+}
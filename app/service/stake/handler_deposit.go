@@ -0,0 +1,97 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleDepositEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + user、poolId两个indexed参数）
+	if len(l.Topics) < 3 {
+		return fmt.Errorf("HandleDepositEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	userAddress := topicToAddress(l.Topics[1])
+	poolID := int32(l.Topics[2].Big().Int64())
+
+	// 解析非indexed参数（data中）
+	params, err := t.ABI.Events["Deposit"].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("HandleDepositEvent: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("HandleDepositEvent: invalid params length")
+	}
+	amountWei := params[0].(*big.Int) // 质押数量
+	amount := types.NewBigInt(amountWei)
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleDepositEvent: get block error: %w", err)
+	}
+
+	// 写入 event_deposit
+	if err := stakeevents.CreateDeposit(ctx, t.DB, &model.EventDeposit{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		UserAddress:     userAddress,
+		PoolID:          poolID,
+		Amount:          amount,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleDepositEvent: create event_deposit error: %w", err)
+	}
+
+	// 与 MetaNodeStake._deposit 一致：结算此前累计的奖励，再更新质押数量和 finishedMetaNode
+	// 同一交易中 updatePool 触发的 UpdatePool 事件先于 Deposit 处理，此时 pool_info 已更新到当前区块
+	pool, err := poolinfo.GetByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleDepositEvent: get pool_info error: %w", err)
+	}
+	stats, err := userpoolstats.FirstOrCreate(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleDepositEvent: FirstOrCreate user_pool_stats error: %w", err)
+	}
+	poolState, userState, err := reward.Deposit(reward.PoolFromModel(pool), reward.UserFromModel(stats), amountWei)
+	if err != nil {
+		return fmt.Errorf("HandleDepositEvent: reward.Deposit error: %w", err)
+	}
+
+	// 更新 user_pool_stats：当前质押、奖励状态、累计质押、最后质押区块
+	if err := userpoolstats.UpdateByUserPoolAndContract(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address, map[string]interface{}{
+		"st_amount":          types.NewBigInt(userState.StAmount),
+		"finished_metanode":  types.NewBigInt(userState.FinishedMetaNode),
+		"pending_metanode":   types.NewBigInt(userState.PendingMetaNode),
+		"settled_metanode":   types.NewBigInt(userState.PendingMetaNode),
+		"total_deposited":    types.IncrExpr("total_deposited", amount),
+		"last_deposit_block": l.BlockNumber,
+	}); err != nil {
+		return fmt.Errorf("HandleDepositEvent: update user_pool_stats error: %w", err)
+	}
+
+	// 更新资金池质押总量
+	if err := poolinfo.UpdateByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address, map[string]interface{}{
+		"st_token_amount": types.NewBigInt(poolState.StTokenAmount),
+	}); err != nil {
+		return fmt.Errorf("HandleDepositEvent: update pool_info error: %w", err)
+	}
+
+	return nil
+}
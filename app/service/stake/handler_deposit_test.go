@@ -0,0 +1,67 @@
+package stake
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleDepositEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	// 用户尚未参与资金池，首次质押时创建 user_pool_stats
+	seedPool(t, task, 0, "100", "2000000000000000000", "0", 10)
+
+	tests := []struct {
+		name      string
+		acc       string // 同一交易中 UpdatePool 更新后的 accMetaNodePerST
+		block     uint64
+		txHash    string
+		amount    int64
+		wantStats [4]string // st_amount, finished_metanode, pending_metanode, total_deposited
+		wantPool  string
+	}{
+		// 首次质押：没有历史奖励，finishedMetaNode = 300*2
+		{"first deposit", "2000000000000000000", 100, "0x01", 300, [4]string{"300", "600", "0", "300"}, "300"},
+		// 再次质押先结算 300*3-600=300 的奖励，再按新的质押数量重置 finishedMetaNode
+		{"second deposit", "3000000000000000000", 110, "0x02", 100, [4]string{"400", "1200", "300", "400"}, "400"},
+		// 数量为 0 时只结算奖励（此时没有新奖励），更新最后质押区块
+		{"zero amount", "3000000000000000000", 120, "0x03", 0, [4]string{"400", "1200", "300", "400"}, "400"},
+	}
+	for _, tt := range tests {
+		if err := task.DB.Exec(`UPDATE pool_info SET acc_metanode_per_st = ? WHERE pool_id = 0`, tt.acc).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := task.HandleDepositEvent(eventLog(t, task, "Deposit", tt.block, tt.txHash, 2, testUser, big.NewInt(0), big.NewInt(tt.amount))); err != nil {
+			t.Fatalf("%s: HandleDepositEvent() error: %v", tt.name, err)
+		}
+
+		var ev model.EventDeposit
+		if err := task.DB.Where("block_number = ?", tt.block).First(&ev).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ev.UserAddress != testUser.Hex() || ev.PoolID != 0 || ev.Amount.String() != fmt.Sprint(tt.amount) || ev.BlockTimestamp != testTimestamp+tt.block ||
+			ev.TransactionHash != ethCommon.HexToHash(tt.txHash).Hex() || ev.LogIndex != 2 {
+			t.Fatalf("%s: event_deposit = %+v", tt.name, ev)
+		}
+		stats := getStats(t, task, 0)
+		got := [4]string{stats.StAmount.String(), stats.FinishedMetanode.String(), stats.PendingMetanode.String(), stats.TotalDeposited.String()}
+		if got != tt.wantStats {
+			t.Fatalf("%s: user_pool_stats = %v, want %v", tt.name, got, tt.wantStats)
+		}
+		if stats.LastDepositBlock == nil || *stats.LastDepositBlock != tt.block {
+			t.Fatalf("%s: last_deposit_block = %v, want %d", tt.name, stats.LastDepositBlock, tt.block)
+		}
+		if pool := getPool(t, task, 0); pool.StTokenAmount.String() != tt.wantPool {
+			t.Fatalf("%s: pool st_token_amount = %s, want %s", tt.name, pool.StTokenAmount, tt.wantPool)
+		}
+	}
+
+	var count int64
+	if err := task.DB.Model(&model.UserPoolStat{}).Count(&count).Error; err != nil || count != 1 {
+		t.Fatalf("user_pool_stats rows = %d, %v, want 1", count, err)
+	}
+}
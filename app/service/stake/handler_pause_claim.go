@@ -0,0 +1,52 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandlePauseClaimEvent(l ethereumTypes.Log) error {
+	return t.handlePauseClaim(l, true)
+}
+
+func (t *TaskStake) HandleUnpauseClaimEvent(l ethereumTypes.Log) error {
+	return t.handlePauseClaim(l, false)
+}
+
+// handlePauseClaim PauseClaim / UnpauseClaim 事件没有参数，仅通过事件类型区分暂停与恢复
+func (t *TaskStake) handlePauseClaim(l ethereumTypes.Log, isPaused bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("handlePauseClaim: get block error: %w", err)
+	}
+
+	// 写入 event_pause_claim
+	if err := stakeevents.CreatePauseClaim(ctx, t.DB, &model.EventPauseClaim{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		IsPaused:        isPaused,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("handlePauseClaim: create event_pause_claim error: %w", err)
+	}
+
+	// 同步 stake_contract_state 中的领取暂停标记
+	if err := t.updateContractState(ctx, l.BlockNumber, map[string]interface{}{
+		"claim_paused": isPaused,
+	}); err != nil {
+		return fmt.Errorf("handlePauseClaim: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandlePauseWithdrawEvent(l ethereumTypes.Log) error {
+	return t.handlePauseWithdraw(l, true)
+}
+
+func (t *TaskStake) HandleUnpauseWithdrawEvent(l ethereumTypes.Log) error {
+	return t.handlePauseWithdraw(l, false)
+}
+
+// handlePauseWithdraw PauseWithdraw / UnpauseWithdraw 事件没有参数，仅通过事件类型区分暂停与恢复
+func (t *TaskStake) handlePauseWithdraw(l ethereumTypes.Log, isPaused bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("handlePauseWithdraw: get block error: %w", err)
+	}
+
+	// 写入 event_pause_withdraw
+	if err := stakeevents.CreatePauseWithdraw(ctx, t.DB, &model.EventPauseWithdraw{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		IsPaused:        isPaused,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("handlePauseWithdraw: create event_pause_withdraw error: %w", err)
+	}
+
+	// 同步 stake_contract_state 中的提现暂停标记
+	if err := t.updateContractState(ctx, l.BlockNumber, map[string]interface{}{
+		"withdraw_paused": isPaused,
+	}); err != nil {
+		return fmt.Errorf("handlePauseWithdraw: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package stake
+
+import (
+	"fmt"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandlePauseWithdrawEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 50)
+	zero := ethCommon.Address{}.Hex()
+
+	tests := []struct {
+		name   string
+		event  string
+		handle func(l ethereumTypes.Log) error
+		block  uint64
+		paused bool
+	}{
+		{"pause", "PauseWithdraw", task.HandlePauseWithdrawEvent, 100, true},
+		{"unpause", "UnpauseWithdraw", task.HandleUnpauseWithdrawEvent, 110, false},
+	}
+	for i, tt := range tests {
+		txHash := fmt.Sprintf("0x%02x", i+1)
+		if err := tt.handle(eventLog(t, task, tt.event, tt.block, txHash, 0)); err != nil {
+			t.Fatalf("%s: handler error: %v", tt.name, err)
+		}
+
+		var ev model.EventPauseWithdraw
+		if err := task.DB.Where("block_number = ?", tt.block).First(&ev).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ev.IsPaused != tt.paused || ev.BlockTimestamp != testTimestamp+tt.block || ev.TransactionHash != ethCommon.HexToHash(txHash).Hex() {
+			t.Fatalf("%s: event_pause_withdraw = %+v", tt.name, ev)
+		}
+		checkContractState(t, task, tt.block, fmt.Sprintf("%s 10 1000 5 %v false false", zero, tt.paused))
+	}
+	if n := len(contractStateHistory(t, task)); n != 2 {
+		t.Fatalf("stake_contract_state_history = %d rows, want 2", n)
+	}
+}
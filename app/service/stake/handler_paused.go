@@ -0,0 +1,65 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// HandlePausedEvent OpenZeppelin PausableUpgradeable 的 Paused(account) 事件，暂停后 deposit/unstake/withdraw/claim 均不可用
+func (t *TaskStake) HandlePausedEvent(l ethereumTypes.Log) error {
+	return t.handlePaused(l, "Paused", true)
+}
+
+// HandleUnpausedEvent OpenZeppelin PausableUpgradeable 的 Unpaused(account) 事件
+func (t *TaskStake) HandleUnpausedEvent(l ethereumTypes.Log) error {
+	return t.handlePaused(l, "Unpaused", false)
+}
+
+func (t *TaskStake) handlePaused(l ethereumTypes.Log, eventName string, isPaused bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析非indexed参数（data中）
+	params, err := t.ABI.Events[eventName].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("handlePaused: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("handlePaused: invalid params length")
+	}
+	account := params[0].(ethCommon.Address).Hex() // 触发暂停/恢复的账户
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("handlePaused: get block error: %w", err)
+	}
+
+	// 写入 event_paused
+	if err := stakeevents.CreatePaused(ctx, t.DB, &model.EventPaused{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		IsPaused:        isPaused,
+		Account:         account,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("handlePaused: create event_paused error: %w", err)
+	}
+
+	// 同步 stake_contract_state 中的合约暂停标记
+	if err := t.updateContractState(ctx, l.BlockNumber, map[string]interface{}{
+		"paused": isPaused,
+	}); err != nil {
+		return fmt.Errorf("handlePaused: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package stake
+
+import (
+	"fmt"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandlePausedEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 50)
+	zero := ethCommon.Address{}.Hex()
+	admin := ethCommon.HexToAddress("0x4444444444444444444444444444444444444444")
+
+	tests := []struct {
+		name   string
+		event  string
+		handle func(l ethereumTypes.Log) error
+		block  uint64
+		paused bool
+	}{
+		{"pause", "Paused", task.HandlePausedEvent, 100, true},
+		{"unpause", "Unpaused", task.HandleUnpausedEvent, 110, false},
+	}
+	for i, tt := range tests {
+		txHash := fmt.Sprintf("0x%02x", i+1)
+		if err := tt.handle(eventLog(t, task, tt.event, tt.block, txHash, 0, admin)); err != nil {
+			t.Fatalf("%s: handler error: %v", tt.name, err)
+		}
+
+		var ev model.EventPaused
+		if err := task.DB.Where("block_number = ?", tt.block).First(&ev).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ev.IsPaused != tt.paused || ev.Account != admin.Hex() || ev.BlockTimestamp != testTimestamp+tt.block ||
+			ev.TransactionHash != ethCommon.HexToHash(txHash).Hex() {
+			t.Fatalf("%s: event_paused = %+v", tt.name, ev)
+		}
+		checkContractState(t, task, tt.block, fmt.Sprintf("%s 10 1000 5 false false %v", zero, tt.paused))
+	}
+
+	// data 中缺少 account 时不写入任何数据
+	l := eventLog(t, task, "Paused", 120, "0x10", 0, admin)
+	l.Data = nil
+	if err := task.HandlePausedEvent(l); err == nil {
+		t.Fatal("HandlePausedEvent() without account succeeded, want error")
+	}
+	var count int64
+	if err := task.DB.Model(&model.EventPaused{}).Count(&count).Error; err != nil || count != 2 {
+		t.Fatalf("event_paused = %d, %v, want 2", count, err)
+	}
+}
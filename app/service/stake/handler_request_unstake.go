@@ -0,0 +1,119 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/unstakerequests"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleRequestUnstakeEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + user、poolId两个indexed参数）
+	if len(l.Topics) < 3 {
+		return fmt.Errorf("HandleRequestUnstakeEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	userAddress := topicToAddress(l.Topics[1])
+	poolID := int32(l.Topics[2].Big().Int64())
+
+	// 解析非indexed参数（data中）
+	params, err := t.ABI.Events["RequestUnstake"].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("HandleRequestUnstakeEvent: invalid params length")
+	}
+	amountWei := params[0].(*big.Int) // 解质押数量
+	amount := types.NewBigInt(amountWei)
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: get block error: %w", err)
+	}
+
+	// 事件按区块顺序处理，此时 pool_info 中的解锁区块数即为该区块时的值
+	pool, err := poolinfo.GetByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: get pool_info error: %w", err)
+	}
+
+	// 写入 event_request_unstake
+	if err := stakeevents.CreateRequestUnstake(ctx, t.DB, &model.EventRequestUnstake{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		UserAddress:     userAddress,
+		PoolID:          poolID,
+		Amount:          amount,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: create event_request_unstake error: %w", err)
+	}
+
+	// 与 MetaNodeStake.unstake 一致：结算此前累计的奖励，再扣减质押数量并更新 finishedMetaNode
+	stats, err := userpoolstats.FirstOrCreate(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: FirstOrCreate user_pool_stats error: %w", err)
+	}
+	poolState, userState, err := reward.Unstake(reward.PoolFromModel(pool), reward.UserFromModel(stats), amountWei)
+	if err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: reward.Unstake error: %w", err)
+	}
+
+	// 更新 user_pool_stats：当前质押、奖励状态、累计解质押
+	if err := userpoolstats.UpdateByUserPoolAndContract(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address, map[string]interface{}{
+		"st_amount":         types.NewBigInt(userState.StAmount),
+		"finished_metanode": types.NewBigInt(userState.FinishedMetaNode),
+		"pending_metanode":  types.NewBigInt(userState.PendingMetaNode),
+		"settled_metanode":  types.NewBigInt(userState.PendingMetaNode),
+		"total_unstaked":    types.IncrExpr("total_unstaked", amount),
+	}); err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: update user_pool_stats error: %w", err)
+	}
+
+	// 更新资金池质押总量
+	if err := poolinfo.UpdateByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address, map[string]interface{}{
+		"st_token_amount": types.NewBigInt(poolState.StTokenAmount),
+	}); err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: update pool_info error: %w", err)
+	}
+
+	// amount 为0时合约不会生成解质押请求
+	if amount.Sign() == 0 {
+		return nil
+	}
+
+	// 与 MetaNodeStake.unstake 一致：unlockBlocks = block.number + unstakeLockedBlocks
+	isWithdrawn := false
+	if err := unstakerequests.Create(ctx, t.DB, &model.UserUnstakeRequest{
+		ChainID:         t.ChainID,
+		UserAddress:     userAddress,
+		PoolID:          poolID,
+		ContractAddress: t.Address,
+		Amount:          amount,
+		UnlockBlock:     l.BlockNumber + uint64(pool.UnstakeLockedBlocks),
+		RequestBlock:    l.BlockNumber,
+		RequestTx:       l.TxHash.Hex(),
+		IsWithdrawn:     &isWithdrawn,
+	}); err != nil {
+		return fmt.Errorf("HandleRequestUnstakeEvent: create user_unstake_requests error: %w", err)
+	}
+
+	return nil
+}
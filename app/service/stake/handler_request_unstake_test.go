@@ -0,0 +1,75 @@
+package stake
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleRequestUnstakeEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	// accMetaNodePerST = 2 ether：每个质押单位累计 2 个 MetaNode 最小单位
+	seedPool(t, task, 0, "100", "2000000000000000000", "1000", 10)
+	seedStats(t, task, 0, "600", "400", "50")
+
+	tests := []struct {
+		name      string
+		block     uint64
+		txHash    string
+		amount    int64
+		wantStats [4]string // st_amount, finished_metanode, pending_metanode, total_unstaked
+		wantPool  string
+		wantReqs  int
+	}{
+		// 结算 600*2-400=800 的奖励，剩余质押 400 按当前 accMetaNodePerST 重置 finishedMetaNode，生成在 100+10 区块解锁的请求
+		{"unstake", 100, "0x01", 200, [4]string{"400", "800", "850", "200"}, "800", 1},
+		// 数量为 0 时只结算奖励，合约不会生成解质押请求
+		{"zero amount", 105, "0x02", 0, [4]string{"400", "800", "850", "200"}, "800", 1},
+	}
+	for _, tt := range tests {
+		if err := task.HandleRequestUnstakeEvent(eventLog(t, task, "RequestUnstake", tt.block, tt.txHash, 1, testUser, big.NewInt(0), big.NewInt(tt.amount))); err != nil {
+			t.Fatalf("%s: HandleRequestUnstakeEvent() error: %v", tt.name, err)
+		}
+
+		var ev model.EventRequestUnstake
+		if err := task.DB.Where("block_number = ?", tt.block).First(&ev).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ev.UserAddress != testUser.Hex() || ev.PoolID != 0 || ev.Amount.String() != fmt.Sprint(tt.amount) || ev.BlockTimestamp != testTimestamp+tt.block || ev.LogIndex != 1 {
+			t.Fatalf("%s: event_request_unstake = %+v", tt.name, ev)
+		}
+		stats := getStats(t, task, 0)
+		got := [4]string{stats.StAmount.String(), stats.FinishedMetanode.String(), stats.PendingMetanode.String(), stats.TotalUnstaked.String()}
+		if got != tt.wantStats {
+			t.Fatalf("%s: user_pool_stats = %v, want %v", tt.name, got, tt.wantStats)
+		}
+		if pool := getPool(t, task, 0); pool.StTokenAmount.String() != tt.wantPool {
+			t.Fatalf("%s: pool st_token_amount = %s, want %s", tt.name, pool.StTokenAmount, tt.wantPool)
+		}
+		var count int64
+		if err := task.DB.Model(&model.UserUnstakeRequest{}).Count(&count).Error; err != nil || count != int64(tt.wantReqs) {
+			t.Fatalf("%s: user_unstake_requests = %d, %v, want %d", tt.name, count, err, tt.wantReqs)
+		}
+	}
+
+	var req model.UserUnstakeRequest
+	if err := task.DB.First(&req).Error; err != nil {
+		t.Fatal(err)
+	}
+	if req.Amount.String() != "200" || req.UnlockBlock != 110 || req.RequestBlock != 100 || req.RequestTx != ethCommon.HexToHash("0x01").Hex() ||
+		req.IsWithdrawn == nil || *req.IsWithdrawn {
+		t.Fatalf("user_unstake_requests = %+v", req)
+	}
+
+	// 解质押数量超过当前质押时合约会回滚，handler 返回错误
+	err := task.HandleRequestUnstakeEvent(eventLog(t, task, "RequestUnstake", 110, "0x03", 0, testUser, big.NewInt(0), big.NewInt(401)))
+	if !errors.Is(err, reward.ErrNotEnoughStAmount) {
+		t.Fatalf("HandleRequestUnstakeEvent() error = %v, want %v", err, reward.ErrNotEnoughStAmount)
+	}
+}
@@ -0,0 +1,51 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleSetEndBlockEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + endBlock一个indexed参数）
+	if len(l.Topics) < 2 {
+		return fmt.Errorf("HandleSetEndBlockEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	endBlock := l.Topics[1].Big().Uint64() // 质押结束区块
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleSetEndBlockEvent: get block error: %w", err)
+	}
+
+	// 写入 event_set_end_block
+	if err := stakeevents.CreateSetEndBlock(ctx, t.DB, &model.EventSetEndBlock{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		EndBlock:        endBlock,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleSetEndBlockEvent: create event_set_end_block error: %w", err)
+	}
+
+	// 同步 stake_contract_state 最新状态并记录参数历史
+	if err := t.updateContractState(ctx, l.BlockNumber, map[string]interface{}{
+		"end_block": endBlock,
+	}); err != nil {
+		return fmt.Errorf("HandleSetEndBlockEvent: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package stake
+
+import (
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleSetEndBlockEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 50)
+
+	if err := task.HandleSetEndBlockEvent(eventLog(t, task, "SetEndBlock", 100, "0x01", 2, big.NewInt(2000))); err != nil {
+		t.Fatalf("HandleSetEndBlockEvent() error: %v", err)
+	}
+
+	var ev model.EventSetEndBlock
+	if err := task.DB.First(&ev).Error; err != nil {
+		t.Fatal(err)
+	}
+	if ev.EndBlock != 2000 || ev.BlockNumber != 100 || ev.BlockTimestamp != testTimestamp+100 ||
+		ev.TransactionHash != ethCommon.HexToHash("0x01").Hex() || ev.LogIndex != 2 {
+		t.Fatalf("event_set_end_block = %+v", ev)
+	}
+	checkContractState(t, task, 100, ethCommon.Address{}.Hex()+" 10 2000 5 false false false")
+}
@@ -0,0 +1,51 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleSetMetaNodeEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + MetaNode一个indexed参数）
+	if len(l.Topics) < 2 {
+		return fmt.Errorf("HandleSetMetaNodeEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	metaNodeToken := topicToAddress(l.Topics[1]) // MetaNode代币地址
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleSetMetaNodeEvent: get block error: %w", err)
+	}
+
+	// 写入 event_set_metanode
+	if err := stakeevents.CreateSetMetanode(ctx, t.DB, &model.EventSetMetanode{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		MetanodeToken:   metaNodeToken,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleSetMetaNodeEvent: create event_set_metanode error: %w", err)
+	}
+
+	// 同步 stake_contract_state 最新状态并记录参数历史
+	if err := t.updateContractState(ctx, l.BlockNumber, map[string]interface{}{
+		"metanode_token": metaNodeToken,
+	}); err != nil {
+		return fmt.Errorf("HandleSetMetaNodeEvent: %w", err)
+	}
+
+	return nil
+}
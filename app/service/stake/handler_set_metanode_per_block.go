@@ -0,0 +1,52 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleSetMetaNodePerBlockEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + MetaNodePerBlock一个indexed参数）
+	if len(l.Topics) < 2 {
+		return fmt.Errorf("HandleSetMetaNodePerBlockEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	metaNodePerBlock := types.NewBigInt(l.Topics[1].Big()) // 每区块MetaNode奖励
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleSetMetaNodePerBlockEvent: get block error: %w", err)
+	}
+
+	// 写入 event_set_metanode_per_block
+	if err := stakeevents.CreateSetMetanodePerBlock(ctx, t.DB, &model.EventSetMetanodePerBlock{
+		ChainID:          t.ChainID,
+		ContractAddress:  t.Address,
+		MetanodePerBlock: metaNodePerBlock,
+		BlockNumber:      l.BlockNumber,
+		BlockTimestamp:   blockTimestamp,
+		TransactionHash:  l.TxHash.Hex(),
+		LogIndex:         int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleSetMetaNodePerBlockEvent: create event_set_metanode_per_block error: %w", err)
+	}
+
+	// 同步 stake_contract_state 最新状态并记录参数历史
+	if err := t.updateContractState(ctx, l.BlockNumber, map[string]interface{}{
+		"metanode_per_block": metaNodePerBlock,
+	}); err != nil {
+		return fmt.Errorf("HandleSetMetaNodePerBlockEvent: %w", err)
+	}
+
+	return nil
+}
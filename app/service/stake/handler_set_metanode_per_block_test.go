@@ -0,0 +1,31 @@
+package stake
+
+import (
+	"math/big"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleSetMetaNodePerBlockEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 50)
+
+	// 超过 int64 范围的奖励按原始单位精确保存
+	perBlock, _ := new(big.Int).SetString("20000000000000000000", 10)
+	if err := task.HandleSetMetaNodePerBlockEvent(eventLog(t, task, "SetMetaNodePerBlock", 100, "0x01", 2, perBlock)); err != nil {
+		t.Fatalf("HandleSetMetaNodePerBlockEvent() error: %v", err)
+	}
+
+	var ev model.EventSetMetanodePerBlock
+	if err := task.DB.First(&ev).Error; err != nil {
+		t.Fatal(err)
+	}
+	if ev.MetanodePerBlock.String() != perBlock.String() || ev.BlockNumber != 100 || ev.BlockTimestamp != testTimestamp+100 ||
+		ev.TransactionHash != ethCommon.HexToHash("0x01").Hex() || ev.LogIndex != 2 {
+		t.Fatalf("event_set_metanode_per_block = %+v", ev)
+	}
+	checkContractState(t, task, 100, ethCommon.Address{}.Hex()+" 10 1000 20000000000000000000 false false false")
+}
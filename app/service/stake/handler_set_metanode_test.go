@@ -0,0 +1,29 @@
+package stake
+
+import (
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleSetMetaNodeEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 50)
+
+	token := ethCommon.HexToAddress("0x3333333333333333333333333333333333333333")
+	if err := task.HandleSetMetaNodeEvent(eventLog(t, task, "SetMetaNode", 100, "0x01", 2, token)); err != nil {
+		t.Fatalf("HandleSetMetaNodeEvent() error: %v", err)
+	}
+
+	var ev model.EventSetMetanode
+	if err := task.DB.First(&ev).Error; err != nil {
+		t.Fatal(err)
+	}
+	if ev.MetanodeToken != token.Hex() || ev.BlockNumber != 100 || ev.BlockTimestamp != testTimestamp+100 ||
+		ev.TransactionHash != ethCommon.HexToHash("0x01").Hex() || ev.LogIndex != 2 {
+		t.Fatalf("event_set_metanode = %+v", ev)
+	}
+	checkContractState(t, task, 100, token.Hex()+" 10 1000 5 false false false")
+}
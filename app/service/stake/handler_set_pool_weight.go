@@ -0,0 +1,72 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleSetPoolWeightEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + poolId、poolWeight两个indexed参数）
+	if len(l.Topics) < 3 {
+		return fmt.Errorf("HandleSetPoolWeightEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	poolID := int32(l.Topics[1].Big().Int64())
+	poolWeight := types.NewBigInt(l.Topics[2].Big()) // 新的资金池权重
+
+	// 解析非indexed参数（data中）
+	params, err := t.ABI.Events["SetPoolWeight"].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("HandleSetPoolWeightEvent: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("HandleSetPoolWeightEvent: invalid params length")
+	}
+	totalPoolWeight := types.NewBigInt(params[0].(*big.Int)) // 所有池的总权重
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleSetPoolWeightEvent: get block error: %w", err)
+	}
+
+	// 写入 event_set_pool_weight
+	if err := stakeevents.CreateSetPoolWeight(ctx, t.DB, &model.EventSetPoolWeight{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		PoolID:          poolID,
+		PoolWeight:      poolWeight,
+		TotalPoolWeight: totalPoolWeight,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleSetPoolWeightEvent: create event_set_pool_weight error: %w", err)
+	}
+
+	// 同步 pool_info 最新状态：当前池的权重，以及同一合约下所有池共享的总权重
+	if err := poolinfo.UpdateByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address, map[string]interface{}{
+		"pool_weight": poolWeight,
+	}); err != nil {
+		return fmt.Errorf("HandleSetPoolWeightEvent: update pool_info error: %w", err)
+	}
+	if err := poolinfo.UpdateByContract(ctx, t.DB, t.ChainID, t.Address, map[string]interface{}{
+		"total_pool_weight": totalPoolWeight,
+	}); err != nil {
+		return fmt.Errorf("HandleSetPoolWeightEvent: update total_pool_weight error: %w", err)
+	}
+
+	return nil
+}
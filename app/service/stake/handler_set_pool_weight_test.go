@@ -0,0 +1,34 @@
+package stake
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleSetPoolWeightEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedPool(t, task, 0, "100", "0", "0", 10)
+	seedPool(t, task, 1, "300", "0", "0", 10)
+
+	if err := task.HandleSetPoolWeightEvent(eventLog(t, task, "SetPoolWeight", 80, "0x01", 0, big.NewInt(0), big.NewInt(200), big.NewInt(500))); err != nil {
+		t.Fatal(err)
+	}
+
+	var ev model.EventSetPoolWeight
+	if err := task.DB.First(&ev).Error; err != nil {
+		t.Fatal(err)
+	}
+	if ev.PoolID != 0 || ev.PoolWeight.String() != "200" || ev.TotalPoolWeight.String() != "500" || ev.BlockTimestamp != testTimestamp+80 {
+		t.Fatalf("event_set_pool_weight = %+v", ev)
+	}
+
+	// 只修改该池的权重，总权重取自事件并写入所有池
+	for poolID, want := range []string{"200", "300"} {
+		pool := getPool(t, task, int32(poolID))
+		if pool.PoolWeight.String() != want || pool.TotalPoolWeight == nil || pool.TotalPoolWeight.String() != "500" {
+			t.Fatalf("pool %d weight = %s, total = %v, want %s, 500", poolID, pool.PoolWeight, pool.TotalPoolWeight, want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleSetStartBlockEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + startBlock一个indexed参数）
+	if len(l.Topics) < 2 {
+		return fmt.Errorf("HandleSetStartBlockEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	startBlock := l.Topics[1].Big().Uint64() // 质押开始区块
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleSetStartBlockEvent: get block error: %w", err)
+	}
+
+	// 写入 event_set_start_block
+	if err := stakeevents.CreateSetStartBlock(ctx, t.DB, &model.EventSetStartBlock{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		StartBlock:      startBlock,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleSetStartBlockEvent: create event_set_start_block error: %w", err)
+	}
+
+	// 同步 stake_contract_state 最新状态并记录参数历史
+	if err := t.updateContractState(ctx, l.BlockNumber, map[string]interface{}{
+		"start_block": startBlock,
+	}); err != nil {
+		return fmt.Errorf("HandleSetStartBlockEvent: %w", err)
+	}
+
+	return nil
+}
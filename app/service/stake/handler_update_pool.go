@@ -0,0 +1,78 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleUpdatePoolEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + poolId、lastRewardBlock两个indexed参数）
+	if len(l.Topics) < 3 {
+		return fmt.Errorf("HandleUpdatePoolEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	poolID := int32(l.Topics[1].Big().Int64())
+	lastRewardBlock := l.Topics[2].Big().Uint64() // 最后奖励区块
+
+	// 解析非indexed参数（data中）
+	params, err := t.ABI.Events["UpdatePool"].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("HandleUpdatePoolEvent: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("HandleUpdatePoolEvent: invalid params length")
+	}
+	totalMetaNode := params[0].(*big.Int) // 本次更新分配给该池的MetaNode
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleUpdatePoolEvent: get block error: %w", err)
+	}
+
+	pool, err := poolinfo.GetByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleUpdatePoolEvent: get pool_info error: %w", err)
+	}
+
+	// 写入 event_update_pool
+	if err := stakeevents.CreateUpdatePool(ctx, t.DB, &model.EventUpdatePool{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		PoolID:          poolID,
+		LastRewardBlock: lastRewardBlock,
+		TotalMetanode:   types.NewBigInt(totalMetaNode),
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleUpdatePoolEvent: create event_update_pool error: %w", err)
+	}
+
+	// 与 MetaNodeStake.updatePool 一致：stSupply > 0 时 accMetaNodePerST += totalMetaNode * 1 ether / stSupply，
+	// 使用奖励计算的同一实现，保证同步的 acc_metanode_per_st 与待领取奖励的计算一致
+	updates := map[string]interface{}{
+		"last_reward_block": lastRewardBlock,
+	}
+	if current := reward.PoolFromModel(pool); current.StTokenAmount.Sign() > 0 {
+		updates["acc_metanode_per_st"] = types.NewBigInt(reward.AccumulateMetaNode(current.AccMetaNodePerST, totalMetaNode, current.StTokenAmount))
+	}
+	if err := poolinfo.UpdateByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address, updates); err != nil {
+		return fmt.Errorf("HandleUpdatePoolEvent: update pool_info error: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func (t *TaskStake) HandleUpdatePoolInfoEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + poolId、minDepositAmount、unstakeLockedBlocks三个indexed参数）
+	if len(l.Topics) < 4 {
+		return fmt.Errorf("HandleUpdatePoolInfoEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	poolID := int32(l.Topics[1].Big().Int64())
+	minDepositAmount := types.NewBigInt(l.Topics[2].Big())  // 最小质押数量
+	unstakeLockedBlocks := int32(l.Topics[3].Big().Int64()) // 解锁区块数
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleUpdatePoolInfoEvent: get block error: %w", err)
+	}
+
+	// 写入 event_update_pool_info
+	if err := stakeevents.CreateUpdatePoolInfo(ctx, t.DB, &model.EventUpdatePoolInfo{
+		ChainID:             t.ChainID,
+		ContractAddress:     t.Address,
+		PoolID:              poolID,
+		MinDepositAmount:    minDepositAmount,
+		UnstakeLockedBlocks: unstakeLockedBlocks,
+		BlockNumber:         l.BlockNumber,
+		BlockTimestamp:      blockTimestamp,
+		TransactionHash:     l.TxHash.Hex(),
+		LogIndex:            int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleUpdatePoolInfoEvent: create event_update_pool_info error: %w", err)
+	}
+
+	// 同步 pool_info 最新状态
+	if err := poolinfo.UpdateByPoolIDAndContract(ctx, t.DB, t.ChainID, poolID, t.Address, map[string]interface{}{
+		"min_deposit_amount":    minDepositAmount,
+		"unstake_locked_blocks": unstakeLockedBlocks,
+	}); err != nil {
+		return fmt.Errorf("HandleUpdatePoolInfoEvent: update pool_info error: %w", err)
+	}
+
+	return nil
+}
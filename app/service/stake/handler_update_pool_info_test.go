@@ -0,0 +1,38 @@
+package stake
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleUpdatePoolInfoEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedPool(t, task, 0, "100", "0", "0", 10)
+	seedPool(t, task, 1, "100", "0", "0", 10)
+
+	// 三个参数均为 indexed，事件没有 data
+	l := eventLog(t, task, "UpdatePoolInfo", 70, "0x01", 2, big.NewInt(1), big.NewInt(5000), big.NewInt(30))
+	if len(l.Data) != 0 {
+		t.Fatalf("UpdatePoolInfo data = %x, want empty", l.Data)
+	}
+	if err := task.HandleUpdatePoolInfoEvent(l); err != nil {
+		t.Fatal(err)
+	}
+
+	var ev model.EventUpdatePoolInfo
+	if err := task.DB.First(&ev).Error; err != nil {
+		t.Fatal(err)
+	}
+	if ev.PoolID != 1 || ev.MinDepositAmount.String() != "5000" || ev.UnstakeLockedBlocks != 30 || ev.BlockTimestamp != testTimestamp+70 || ev.LogIndex != 2 {
+		t.Fatalf("event_update_pool_info = %+v", ev)
+	}
+	if pool := getPool(t, task, 1); pool.MinDepositAmount.String() != "5000" || pool.UnstakeLockedBlocks != 30 {
+		t.Fatalf("pool 1 = %s/%d, want 5000/30", pool.MinDepositAmount, pool.UnstakeLockedBlocks)
+	}
+	// 其他资金池不受影响
+	if pool := getPool(t, task, 0); pool.MinDepositAmount.String() != "0" || pool.UnstakeLockedBlocks != 10 {
+		t.Fatalf("pool 0 = %s/%d, want 0/10", pool.MinDepositAmount, pool.UnstakeLockedBlocks)
+	}
+}
@@ -0,0 +1,44 @@
+package stake
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+func TestHandleUpdatePoolEvent(t *testing.T) {
+	tests := []struct {
+		name          string
+		stTokenAmount string
+		totalMetaNode int64
+		wantAcc       string
+	}{
+		// 与 MetaNodeStake.updatePool 一致：accMetaNodePerST += totalMetaNode * 1 ether / stSupply
+		{"staked", "400", 1000, "3500000000000000000"},
+		{"rounds down", "3", 1, "1333333333333333333"},
+		// 池中没有质押时只推进 lastRewardBlock
+		{"empty pool", "0", 1000, "1000000000000000000"},
+	}
+	for _, tt := range tests {
+		task := newTestTaskStake(t)
+		seedPool(t, task, 0, "100", "1000000000000000000", tt.stTokenAmount, 10)
+
+		l := eventLog(t, task, "UpdatePool", 90, "0x01", 0, big.NewInt(0), big.NewInt(90), big.NewInt(tt.totalMetaNode))
+		if err := task.HandleUpdatePoolEvent(l); err != nil {
+			t.Fatalf("%s: HandleUpdatePoolEvent() error: %v", tt.name, err)
+		}
+
+		pool := getPool(t, task, 0)
+		if pool.LastRewardBlock != 90 || pool.AccMetanodePerSt.String() != tt.wantAcc {
+			t.Fatalf("%s: last_reward_block = %d, acc_metanode_per_st = %s, want 90, %s", tt.name, pool.LastRewardBlock, pool.AccMetanodePerSt, tt.wantAcc)
+		}
+		var ev model.EventUpdatePool
+		if err := task.DB.First(&ev).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ev.PoolID != 0 || ev.LastRewardBlock != 90 || ev.TotalMetanode.String() != big.NewInt(tt.totalMetaNode).String() || ev.BlockTimestamp != testTimestamp+90 {
+			t.Fatalf("%s: event_update_pool = %+v", tt.name, ev)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/unstakerequests"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+func (t *TaskStake) HandleWithdrawEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + user、poolId、blockNumber三个indexed参数）
+	if len(l.Topics) < 4 {
+		return fmt.Errorf("HandleWithdrawEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// 解析indexed参数
+	userAddress := topicToAddress(l.Topics[1])
+	poolID := int32(l.Topics[2].Big().Int64())
+	withdrawBlockNumber := l.Topics[3].Big().Uint64() // 提现时的区块号
+
+	// 解析非indexed参数（data中）
+	params, err := t.ABI.Events["Withdraw"].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("HandleWithdrawEvent: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("HandleWithdrawEvent: invalid params length")
+	}
+	amount := types.NewBigInt(params[0].(*big.Int)) // 提现数量
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleWithdrawEvent: get block error: %w", err)
+	}
+
+	requests, err := unstakerequests.ListPendingByUserPoolAndContract(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address)
+	if err != nil {
+		return fmt.Errorf("HandleWithdrawEvent: list user_unstake_requests error: %w", err)
+	}
+	matchedIDs, matchedAmount := matchUnlockedRequests(requests, withdrawBlockNumber)
+
+	mismatch := amount.Cmp(matchedAmount) != 0
+	if mismatch {
+		logx.Errorf("HandleWithdrawEvent: amount mismatch, user=%s, pool=%d, amount=%s, matched=%s, tx=%s",
+			userAddress, poolID, amount, matchedAmount, l.TxHash.Hex())
+	}
+
+	// 写入 event_withdraw，金额不一致时打上标记以便人工核对
+	if err := stakeevents.CreateWithdraw(ctx, t.DB, &model.EventWithdraw{
+		ChainID:             t.ChainID,
+		ContractAddress:     t.Address,
+		UserAddress:         userAddress,
+		PoolID:              poolID,
+		Amount:              amount,
+		WithdrawBlockNumber: withdrawBlockNumber,
+		MatchedAmount:       matchedAmount,
+		AmountMismatch:      mismatch,
+		BlockNumber:         l.BlockNumber,
+		BlockTimestamp:      blockTimestamp,
+		TransactionHash:     l.TxHash.Hex(),
+		LogIndex:            int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleWithdrawEvent: create event_withdraw error: %w", err)
+	}
+
+	// 合约已将这些请求出队，无论金额是否一致都标记为已提现
+	if err := unstakerequests.MarkWithdrawnByIDs(ctx, t.DB, matchedIDs, l.BlockNumber, l.TxHash.Hex()); err != nil {
+		return fmt.Errorf("HandleWithdrawEvent: update user_unstake_requests error: %w", err)
+	}
+
+	if amount.Sign() == 0 {
+		return nil
+	}
+
+	// 更新 user_pool_stats：累计提现金额
+	if _, err := userpoolstats.FirstOrCreate(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address); err != nil {
+		return fmt.Errorf("HandleWithdrawEvent: FirstOrCreate user_pool_stats error: %w", err)
+	}
+	if err := userpoolstats.UpdateByUserPoolAndContract(ctx, t.DB, t.ChainID, userAddress, poolID, t.Address, map[string]interface{}{
+		"total_withdrawn": types.IncrExpr("total_withdrawn", amount),
+	}); err != nil {
+		return fmt.Errorf("HandleWithdrawEvent: update user_pool_stats error: %w", err)
+	}
+
+	return nil
+}
+
+// matchUnlockedRequests 与 MetaNodeStake.withdraw 一致：按申请顺序取出 unlockBlocks <= blockNumber 的前缀，
+// 遇到未解锁的请求即停止，返回匹配到的请求ID和金额合计
+func matchUnlockedRequests(requests []*model.UserUnstakeRequest, blockNumber uint64) ([]int64, types.BigInt) {
+	var ids []int64
+	var amount types.BigInt
+	for _, r := range requests {
+		if r.UnlockBlock > blockNumber {
+			break
+		}
+		ids = append(ids, r.ID)
+		amount = amount.Add(r.Amount)
+	}
+	return ids, amount
+}
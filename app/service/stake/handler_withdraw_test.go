@@ -0,0 +1,131 @@
+package stake
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+func TestMatchUnlockedRequests(t *testing.T) {
+	newRequest := func(id int64, amount int64, unlockBlock uint64) *model.UserUnstakeRequest {
+		return &model.UserUnstakeRequest{ID: id, Amount: types.NewBigIntFromInt64(amount), UnlockBlock: unlockBlock}
+	}
+	// 按申请顺序排列，解锁区块不一定递增（资金池的锁定区块数可能被修改）
+	requests := []*model.UserUnstakeRequest{
+		newRequest(1, 100, 110),
+		newRequest(2, 200, 120),
+		newRequest(3, 300, 150),
+		newRequest(4, 400, 130),
+	}
+
+	tests := []struct {
+		name       string
+		block      uint64
+		wantIDs    []int64
+		wantAmount string
+	}{
+		{"none unlocked", 109, nil, "0"},
+		{"first unlocked", 110, []int64{1}, "100"},
+		{"prefix unlocked", 125, []int64{1, 2}, "300"},
+		// 请求 4 已解锁，但排在未解锁的请求 3 之后，合约不会取出
+		{"stop at first locked", 140, []int64{1, 2}, "300"},
+		{"all unlocked", 150, []int64{1, 2, 3, 4}, "1000"},
+	}
+	for _, tt := range tests {
+		ids, amount := matchUnlockedRequests(requests, tt.block)
+		if !reflect.DeepEqual(ids, tt.wantIDs) || amount.String() != tt.wantAmount {
+			t.Fatalf("%s: matchUnlockedRequests(%d) = %v, %s, want %v, %s", tt.name, tt.block, ids, amount, tt.wantIDs, tt.wantAmount)
+		}
+	}
+
+	if ids, amount := matchUnlockedRequests(nil, 100); ids != nil || amount.Sign() != 0 {
+		t.Fatalf("matchUnlockedRequests(nil) = %v, %s, want nil, 0", ids, amount)
+	}
+}
+
+// seedUnstakeRequest 写入用户在资金池 0 的待提现解质押请求
+func seedUnstakeRequest(t *testing.T, task *TaskStake, user ethCommon.Address, amount string, requestBlock, unlockBlock uint64) {
+	if err := task.DB.Exec(`INSERT INTO user_unstake_requests (chain_id, user_address, pool_id, contract_address, amount, unlock_block, request_block, request_tx)
+		VALUES (?, ?, 0, ?, ?, ?, ?, '0x00')`, testChainID, user.Hex(), testContract, amount, unlockBlock, requestBlock).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHandleWithdrawEvent(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedPool(t, task, 0, "100", "0", "1000", 10)
+	// 按申请顺序：请求 4 先于请求 3 解锁，但合约按 FIFO 在未解锁的请求 3 处停止
+	seedUnstakeRequest(t, task, testUser, "100", 100, 110)
+	seedUnstakeRequest(t, task, testUser, "200", 101, 120)
+	seedUnstakeRequest(t, task, testUser, "300", 102, 150)
+	seedUnstakeRequest(t, task, testUser, "400", 103, 130)
+	// 其他用户的请求不参与匹配
+	other := ethCommon.HexToAddress("0x3333333333333333333333333333333333333333")
+	seedUnstakeRequest(t, task, other, "500", 100, 110)
+
+	tests := []struct {
+		name          string
+		block         uint64
+		txHash        string
+		amount        int64
+		wantMatched   string
+		wantMismatch  bool
+		wantWithdrawn string // 各请求的提现交易，"-" 表示未提现
+		wantTotal     string
+	}{
+		{"unlocked prefix", 125, "0x01", 300, "300", false, "0x01 0x01 - - -", "300"},
+		{"nothing unlocked", 140, "0x02", 0, "0", false, "0x01 0x01 - - -", "300"},
+		// 事件金额与匹配到的请求合计不一致时打上标记，请求仍按合约出队标记为已提现，累计提现以事件为准
+		{"amount mismatch", 150, "0x03", 600, "700", true, "0x01 0x01 0x03 0x03 -", "900"},
+	}
+	for _, tt := range tests {
+		if err := task.HandleWithdrawEvent(eventLog(t, task, "Withdraw", tt.block, tt.txHash, 3, testUser, big.NewInt(0), big.NewInt(tt.amount), new(big.Int).SetUint64(tt.block))); err != nil {
+			t.Fatalf("%s: HandleWithdrawEvent() error: %v", tt.name, err)
+		}
+
+		var ev model.EventWithdraw
+		if err := task.DB.Where("block_number = ?", tt.block).First(&ev).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if ev.UserAddress != testUser.Hex() || ev.PoolID != 0 || ev.Amount.String() != fmt.Sprint(tt.amount) || ev.WithdrawBlockNumber != tt.block ||
+			ev.MatchedAmount.String() != tt.wantMatched || ev.AmountMismatch != tt.wantMismatch || ev.BlockTimestamp != testTimestamp+tt.block || ev.LogIndex != 3 {
+			t.Fatalf("%s: event_withdraw = %+v", tt.name, ev)
+		}
+
+		var requests []*model.UserUnstakeRequest
+		if err := task.DB.Order("id").Find(&requests).Error; err != nil {
+			t.Fatal(err)
+		}
+		var withdrawn []string
+		for _, r := range requests {
+			switch {
+			case r.IsWithdrawn == nil || !*r.IsWithdrawn:
+				withdrawn = append(withdrawn, "-")
+			case r.WithdrawnTx == nil || r.WithdrawnBlock == nil:
+				t.Fatalf("%s: request %d withdrawn without tx or block", tt.name, r.ID)
+			default:
+				tx := map[string]string{ethCommon.HexToHash("0x01").Hex(): "0x01", ethCommon.HexToHash("0x03").Hex(): "0x03"}[*r.WithdrawnTx]
+				withdrawn = append(withdrawn, tx)
+			}
+		}
+		if got := strings.Join(withdrawn, " "); got != tt.wantWithdrawn {
+			t.Fatalf("%s: withdrawn requests = %s, want %s", tt.name, got, tt.wantWithdrawn)
+		}
+
+		// 第一次提现时用户没有 user_pool_stats 记录，由 handler 创建
+		if stats := getStats(t, task, 0); stats.TotalWithdrawn.String() != tt.wantTotal {
+			t.Fatalf("%s: total_withdrawn = %s, want %s", tt.name, stats.TotalWithdrawn, tt.wantTotal)
+		}
+		// 质押总量在解质押时已经扣减，提现不再修改
+		if pool := getPool(t, task, 0); pool.StTokenAmount.String() != "1000" {
+			t.Fatalf("%s: pool st_token_amount = %s, want 1000", tt.name, pool.StTokenAmount)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+-- ========================================
+-- event_withdraw 增加 FIFO 匹配结果列：按旧版 sql/database_schema.sql 建表的数据库没有这些列，
+-- 下面的金额换算会更新 matched_amount。列和索引不存在时才添加，已有记录的匹配结果保持默认值
+-- ========================================
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_withdraw' AND COLUMN_NAME = 'matched_amount') = 0,
+    'ALTER TABLE event_withdraw ADD COLUMN matched_amount DECIMAL(65,18) NOT NULL DEFAULT 0 COMMENT ''按FIFO匹配到的已解锁请求金额合计'' AFTER amount',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_withdraw' AND COLUMN_NAME = 'amount_mismatch') = 0,
+    'ALTER TABLE event_withdraw ADD COLUMN amount_mismatch BOOLEAN NOT NULL DEFAULT FALSE COMMENT ''提现金额与匹配请求金额是否不一致'' AFTER matched_amount',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.STATISTICS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_withdraw' AND INDEX_NAME = 'idx_amount_mismatch') = 0,
+    'ALTER TABLE event_withdraw ADD INDEX idx_amount_mismatch (amount_mismatch)',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+-- ========================================
+-- 合约全局参数表：按旧版 sql/database_schema.sql 建表的数据库没有该表，下面的金额换算会更新 metanode_per_block。
+-- 按换算前的结构建表，后续迁移再换算金额列、增加 chain_id；空表在首次处理参数事件时按部署区块的合约参数初始化
+-- ========================================
+
+CREATE TABLE IF NOT EXISTS stake_contract_state (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    metanode_token VARCHAR(42) COMMENT 'MetaNode代币地址',
+    start_block BIGINT UNSIGNED COMMENT '质押开始区块',
+    end_block BIGINT UNSIGNED COMMENT '质押结束区块',
+    metanode_per_block DECIMAL(65,18) COMMENT '每区块MetaNode奖励',
+    last_updated_block BIGINT UNSIGNED COMMENT '参数最后变更的区块号',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_contract (contract_address)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='合约全局参数表';
+
+-- ========================================
+-- 暂停状态：stake_contract_state 增加暂停状态列（列不存在时才添加），并创建 Paused / Unpaused 事件表。
+-- 已有记录的暂停状态为默认值 FALSE，合约当前处于暂停状态时需按链上的 withdrawPaused / claimPaused / paused 人工更正
+-- ========================================
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'stake_contract_state' AND COLUMN_NAME = 'withdraw_paused') = 0,
+    'ALTER TABLE stake_contract_state ADD COLUMN withdraw_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT ''是否暂停提现'' AFTER metanode_per_block',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'stake_contract_state' AND COLUMN_NAME = 'claim_paused') = 0,
+    'ALTER TABLE stake_contract_state ADD COLUMN claim_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT ''是否暂停领取'' AFTER withdraw_paused',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'stake_contract_state' AND COLUMN_NAME = 'paused') = 0,
+    'ALTER TABLE stake_contract_state ADD COLUMN paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT ''合约是否整体暂停 (OpenZeppelin Pausable)'' AFTER claim_paused',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+CREATE TABLE IF NOT EXISTS event_paused (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    contract_address VARCHAR(42) NOT NULL,
+    is_paused BOOLEAN NOT NULL COMMENT '是否暂停 (true=暂停, false=恢复)',
+    account VARCHAR(42) NOT NULL COMMENT '触发暂停/恢复的账户',
+    block_number BIGINT UNSIGNED NOT NULL,
+    block_timestamp BIGINT UNSIGNED NOT NULL,
+    transaction_hash VARCHAR(66) NOT NULL,
+    log_index INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    INDEX idx_block (block_number),
+    INDEX idx_contract (contract_address)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='合约暂停/恢复事件表';
+
+-- ========================================
+-- 金额列改为按链上原始最小单位存储：DECIMAL(65,18) 的代币单位 -> DECIMAL(65,0) 的整数
+-- 每张表先把已有数据乘以 10^18 再修改列类型；UPDATE 以列的小数位数为条件，中途失败后重跑不会重复换算。
+-- 超过 DECIMAL(65,18) 整数位（10^29 个代币）的金额乘法会溢出并使迁移失败，需人工处理
+-- ========================================
+
+UPDATE pool_info SET
+    acc_metanode_per_st = acc_metanode_per_st * 1000000000000000000,
+    st_token_amount = st_token_amount * 1000000000000000000,
+    min_deposit_amount = min_deposit_amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'pool_info' AND COLUMN_NAME = 'acc_metanode_per_st') = 18;
+
+ALTER TABLE pool_info
+    MODIFY acc_metanode_per_st DECIMAL(65,0) DEFAULT 0 COMMENT '每质押代币累计MetaNode',
+    MODIFY st_token_amount DECIMAL(65,0) DEFAULT 0 COMMENT '质押代币总量',
+    MODIFY min_deposit_amount DECIMAL(65,0) NOT NULL COMMENT '最小质押金额';
+
+UPDATE user_pool_stats SET
+    st_amount = st_amount * 1000000000000000000,
+    finished_metanode = finished_metanode * 1000000000000000000,
+    pending_metanode = pending_metanode * 1000000000000000000,
+    total_deposited = total_deposited * 1000000000000000000,
+    total_unstaked = total_unstaked * 1000000000000000000,
+    total_withdrawn = total_withdrawn * 1000000000000000000,
+    total_claimed = total_claimed * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'user_pool_stats' AND COLUMN_NAME = 'st_amount') = 18;
+
+ALTER TABLE user_pool_stats
+    MODIFY st_amount DECIMAL(65,0) DEFAULT 0 COMMENT '当前质押金额',
+    MODIFY finished_metanode DECIMAL(65,0) DEFAULT 0 COMMENT '已领取的MetaNode',
+    MODIFY pending_metanode DECIMAL(65,0) DEFAULT 0 COMMENT '待领取的MetaNode',
+    MODIFY total_deposited DECIMAL(65,0) DEFAULT 0 COMMENT '累计质押金额',
+    MODIFY total_unstaked DECIMAL(65,0) DEFAULT 0 COMMENT '累计解质押金额',
+    MODIFY total_withdrawn DECIMAL(65,0) DEFAULT 0 COMMENT '累计提现金额',
+    MODIFY total_claimed DECIMAL(65,0) DEFAULT 0 COMMENT '累计领取奖励';
+
+UPDATE event_set_metanode_per_block SET
+    metanode_per_block = metanode_per_block * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_set_metanode_per_block' AND COLUMN_NAME = 'metanode_per_block') = 18;
+
+ALTER TABLE event_set_metanode_per_block
+    MODIFY metanode_per_block DECIMAL(65,0) NOT NULL COMMENT '每区块MetaNode奖励';
+
+UPDATE event_add_pool SET
+    min_deposit_amount = min_deposit_amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_add_pool' AND COLUMN_NAME = 'min_deposit_amount') = 18;
+
+ALTER TABLE event_add_pool
+    MODIFY min_deposit_amount DECIMAL(65,0) NOT NULL COMMENT '最小质押金额';
+
+UPDATE event_update_pool_info SET
+    min_deposit_amount = min_deposit_amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_update_pool_info' AND COLUMN_NAME = 'min_deposit_amount') = 18;
+
+ALTER TABLE event_update_pool_info
+    MODIFY min_deposit_amount DECIMAL(65,0) NOT NULL COMMENT '最小质押金额';
+
+UPDATE event_update_pool SET
+    total_metanode = total_metanode * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_update_pool' AND COLUMN_NAME = 'total_metanode') = 18;
+
+ALTER TABLE event_update_pool
+    MODIFY total_metanode DECIMAL(65,0) NOT NULL COMMENT '本次更新的总MetaNode奖励';
+
+UPDATE event_deposit SET
+    amount = amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_deposit' AND COLUMN_NAME = 'amount') = 18;
+
+ALTER TABLE event_deposit
+    MODIFY amount DECIMAL(65,0) NOT NULL COMMENT '质押金额';
+
+UPDATE event_request_unstake SET
+    amount = amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_request_unstake' AND COLUMN_NAME = 'amount') = 18;
+
+ALTER TABLE event_request_unstake
+    MODIFY amount DECIMAL(65,0) NOT NULL COMMENT '解质押金额';
+
+UPDATE event_withdraw SET
+    amount = amount * 1000000000000000000,
+    matched_amount = matched_amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_withdraw' AND COLUMN_NAME = 'amount') = 18;
+
+ALTER TABLE event_withdraw
+    MODIFY amount DECIMAL(65,0) NOT NULL COMMENT '提现金额',
+    MODIFY matched_amount DECIMAL(65,0) NOT NULL DEFAULT 0 COMMENT '按FIFO匹配到的已解锁请求金额合计';
+
+UPDATE event_claim SET
+    metanode_reward = metanode_reward * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_claim' AND COLUMN_NAME = 'metanode_reward') = 18;
+
+ALTER TABLE event_claim
+    MODIFY metanode_reward DECIMAL(65,0) NOT NULL COMMENT '领取的MetaNode奖励';
+
+UPDATE user_unstake_requests SET
+    amount = amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'user_unstake_requests' AND COLUMN_NAME = 'amount') = 18;
+
+ALTER TABLE user_unstake_requests
+    MODIFY amount DECIMAL(65,0) NOT NULL COMMENT '解质押金额';
+
+UPDATE stake_contract_state SET
+    metanode_per_block = metanode_per_block * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'stake_contract_state' AND COLUMN_NAME = 'metanode_per_block') = 18;
+
+ALTER TABLE stake_contract_state
+    MODIFY metanode_per_block DECIMAL(65,0) COMMENT '每区块MetaNode奖励';
+
+-- 快照中的金额仍为代币单位，无法按新格式恢复，清除后公共祖先早于迁移时间的重组不再恢复这些派生表
+DELETE FROM reorg_snapshots WHERE target_table IN ('pool_info', 'stake_contract_state', 'user_pool_stats', 'user_unstake_requests');
@@ -0,0 +1,18 @@
+-- ========================================
+-- 合约全局参数历史表：每次参数或暂停状态变更后记录完整的参数，用于查询任意区块的合约参数
+-- ========================================
+CREATE TABLE IF NOT EXISTS stake_contract_state_history (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    block_number BIGINT UNSIGNED NOT NULL COMMENT '参数生效的区块号',
+    metanode_token VARCHAR(42) COMMENT 'MetaNode代币地址',
+    start_block BIGINT UNSIGNED COMMENT '质押开始区块',
+    end_block BIGINT UNSIGNED COMMENT '质押结束区块',
+    metanode_per_block DECIMAL(65,0) COMMENT '每区块MetaNode奖励',
+    withdraw_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否暂停提现',
+    claim_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否暂停领取',
+    paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '合约是否整体暂停 (OpenZeppelin Pausable)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_contract_block (contract_address, block_number)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='合约全局参数历史表';
@@ -0,0 +1,265 @@
+-- ========================================
+-- stake 派生表和事件表增加 chain_id
+-- 已有数据库按 chain_contracts 中注册的链补齐 chain_id（同一地址注册在多条链上时无法区分，需人工核对），
+-- 唯一键加上 chain_id，使不同链上相同地址的合约互不覆盖。
+-- 列不存在时才添加（按新的 sql/database_schema.sql 建表时已包含该列），其余语句可重复执行
+-- ========================================
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'pool_info' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE pool_info ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE pool_info t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE pool_info ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE pool_info DROP INDEX uk_pool_contract, ADD UNIQUE KEY uk_pool_contract (chain_id, pool_id, contract_address);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'user_pool_stats' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE user_pool_stats ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE user_pool_stats t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE user_pool_stats ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE user_pool_stats DROP INDEX uk_user_pool, ADD UNIQUE KEY uk_user_pool (chain_id, user_address, pool_id, contract_address);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'user_unstake_requests' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE user_unstake_requests ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE user_unstake_requests t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE user_unstake_requests ALTER COLUMN chain_id DROP DEFAULT;
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'stake_contract_state' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE stake_contract_state ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE stake_contract_state t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE stake_contract_state ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE stake_contract_state DROP INDEX uk_contract, ADD UNIQUE KEY uk_contract (chain_id, contract_address);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'stake_contract_state_history' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE stake_contract_state_history ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE stake_contract_state_history t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE stake_contract_state_history ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE stake_contract_state_history DROP INDEX uk_contract_block, ADD UNIQUE KEY uk_contract_block (chain_id, contract_address, block_number);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_set_metanode' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_set_metanode ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_set_metanode t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_set_metanode ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_set_metanode DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_pause_withdraw' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_pause_withdraw ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_pause_withdraw t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_pause_withdraw ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_pause_withdraw DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_pause_claim' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_pause_claim ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_pause_claim t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_pause_claim ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_pause_claim DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_paused' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_paused ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_paused t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_paused ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_paused DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_set_start_block' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_set_start_block ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_set_start_block t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_set_start_block ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_set_start_block DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_set_end_block' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_set_end_block ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_set_end_block t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_set_end_block ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_set_end_block DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_set_metanode_per_block' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_set_metanode_per_block ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_set_metanode_per_block t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_set_metanode_per_block ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_set_metanode_per_block DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_add_pool' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_add_pool ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_add_pool t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_add_pool ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_add_pool DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_update_pool_info' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_update_pool_info ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_update_pool_info t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_update_pool_info ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_update_pool_info DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_set_pool_weight' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_set_pool_weight ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_set_pool_weight t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_set_pool_weight ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_set_pool_weight DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_update_pool' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_update_pool ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_update_pool t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_update_pool ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_update_pool DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_deposit' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_deposit ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_deposit t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_deposit ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_deposit DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_request_unstake' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_request_unstake ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_request_unstake t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_request_unstake ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_request_unstake DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_withdraw' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_withdraw ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_withdraw t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_withdraw ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_withdraw DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+SET @ddl = IF((SELECT COUNT(*) FROM information_schema.COLUMNS
+                WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'event_claim' AND COLUMN_NAME = 'chain_id') = 0,
+    'ALTER TABLE event_claim ADD COLUMN chain_id INT NOT NULL DEFAULT 0 COMMENT ''链ID'' AFTER id',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+UPDATE event_claim t JOIN chain_contracts c ON c.contract_address = t.contract_address
+SET t.chain_id = c.chain_id
+WHERE t.chain_id = 0;
+ALTER TABLE event_claim ALTER COLUMN chain_id DROP DEFAULT;
+ALTER TABLE event_claim DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
@@ -0,0 +1,7 @@
+-- ========================================
+-- user_pool_stats.pending_metanode 只在用户存入、解质押、领取时按合约结算，不随区块增长；
+-- 查询 API 返回的待领取奖励按同步进度处的区块重新计算（reward.RefreshPending）。更新列注释以区分两者
+-- ========================================
+
+ALTER TABLE user_pool_stats
+    MODIFY pending_metanode DECIMAL(65,0) DEFAULT 0 COMMENT '上次存入、解质押或领取时结算的待领取MetaNode';
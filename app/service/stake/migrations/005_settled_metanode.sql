@@ -0,0 +1,21 @@
+-- ========================================
+-- user_pool_stats 增加 settled_metanode：保存合约 user.pendingMetaNode（上次存入、解质押或领取时结算的值），供事件处理按合约记账；
+-- pending_metanode 恢复为待领取的MetaNode，每个同步区间结束时按区间末尾区块重新计算（撤销 004 对该列含义的修改）。
+-- 列不存在时才添加并用已结算的 pending_metanode 初始化（按新的 sql/database_schema.sql 建表时已包含该列），其余语句可重复执行
+-- ========================================
+
+SET @add_settled = (SELECT COUNT(*) FROM information_schema.COLUMNS
+                     WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'user_pool_stats' AND COLUMN_NAME = 'settled_metanode') = 0;
+SET @ddl = IF(@add_settled,
+    'ALTER TABLE user_pool_stats ADD COLUMN settled_metanode DECIMAL(65,0) DEFAULT 0 COMMENT ''上次存入、解质押或领取时结算的待领取MetaNode'' AFTER pending_metanode',
+    'DO 0');
+PREPARE stmt FROM @ddl;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+SET @dml = IF(@add_settled, 'UPDATE user_pool_stats SET settled_metanode = pending_metanode', 'DO 0');
+PREPARE stmt FROM @dml;
+EXECUTE stmt;
+DEALLOCATE PREPARE stmt;
+
+ALTER TABLE user_pool_stats
+    MODIFY pending_metanode DECIMAL(65,0) DEFAULT 0 COMMENT '待领取的MetaNode';
@@ -0,0 +1,88 @@
+package stake
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/reward"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+// FinishRange 区间处理完成后把区间涉及的资金池中所有持仓的 pending_metanode 刷新为 endBlock 时的待领取奖励，
+// 事件处理按合约记账使用 settled_metanode，刷新不影响后续事件
+func (t *TaskStake) FinishRange(ctx context.Context, logs []ethereumTypes.Log, endBlock uint64) error {
+	poolIDs, err := t.rangePools(ctx, logs)
+	if err != nil {
+		return err
+	}
+	if len(poolIDs) == 0 {
+		return nil
+	}
+	positions, err := userpoolstats.ListByPoolsAndContract(ctx, t.DB, t.ChainID, poolIDs, t.Address)
+	if err != nil {
+		return fmt.Errorf("FinishRange: list user_pool_stats error: %w", err)
+	}
+	// RefreshPendingAt 只替换重新计算过的记录
+	before := make([]*types.BigInt, len(positions))
+	for i, p := range positions {
+		before[i] = p.PendingMetanode
+	}
+	if err := reward.RefreshPendingAt(ctx, t.DB, t.ChainID, t.Address, positions, endBlock); err != nil {
+		return err
+	}
+	for i, p := range positions {
+		if p.PendingMetanode == before[i] || before[i] != nil && p.PendingMetanode.String() == before[i].String() {
+			continue
+		}
+		if err := userpoolstats.UpdateByUserPoolAndContract(ctx, t.DB, t.ChainID, p.UserAddress, p.PoolID, t.Address, map[string]interface{}{
+			"pending_metanode": *p.PendingMetanode,
+		}); err != nil {
+			return fmt.Errorf("FinishRange: update user_pool_stats error: %w", err)
+		}
+	}
+	return nil
+}
+
+// rangePools 区间日志涉及的资金池：Deposit 等事件只影响所在资金池；
+// 其他事件（AddPool、SetPoolWeight、合约参数变更）会改变所有资金池的奖励分配，此时返回全部资金池
+func (t *TaskStake) rangePools(ctx context.Context, logs []ethereumTypes.Log) ([]int32, error) {
+	// 事件签名 => poolId 所在的 topic 下标
+	poolTopic := map[string]int{
+		t.ABI.Events["Deposit"].ID.Hex():        2,
+		t.ABI.Events["RequestUnstake"].ID.Hex(): 2,
+		t.ABI.Events["Withdraw"].ID.Hex():       2,
+		t.ABI.Events["Claim"].ID.Hex():          2,
+		t.ABI.Events["UpdatePool"].ID.Hex():     1,
+		t.ABI.Events["UpdatePoolInfo"].ID.Hex(): 1,
+	}
+	var poolIDs []int32
+	for _, l := range logs {
+		if len(l.Topics) == 0 {
+			continue
+		}
+		i, ok := poolTopic[l.Topics[0].Hex()]
+		if !ok {
+			pools, err := poolinfo.ListByContract(ctx, t.DB, t.ChainID, t.Address)
+			if err != nil {
+				return nil, fmt.Errorf("rangePools: list pool_info error: %w", err)
+			}
+			poolIDs = poolIDs[:0]
+			for _, p := range pools {
+				poolIDs = append(poolIDs, p.PoolID)
+			}
+			return poolIDs, nil
+		}
+		if len(l.Topics) > i {
+			poolID := int32(l.Topics[i].Big().Int64())
+			if !slices.Contains(poolIDs, poolID) {
+				poolIDs = append(poolIDs, poolID)
+			}
+		}
+	}
+	return poolIDs, nil
+}
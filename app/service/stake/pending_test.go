@@ -0,0 +1,47 @@
+package stake
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestFinishRange(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 0)
+	seedPool(t, task, 0, "100", "3000000000000000000", "400", 10)
+	seedPool(t, task, 1, "100", "3000000000000000000", "400", 10)
+	seedStats(t, task, 0, "400", "800", "850")
+	seedStats(t, task, 1, "400", "800", "850")
+
+	tests := []struct {
+		name        string
+		event       string
+		endBlock    uint64
+		wantPending [2]string // 资金池 0、1 的 pending_metanode
+	}{
+		// 只刷新区间涉及的资金池 0：[10, 20) 分配 50，400*3.125-800+850
+		{"pool event", "Claim", 20, [2]string{"1300", "850"}},
+		// 合约参数事件影响所有资金池；按 settled_metanode 重新计算，不累加上次刷新的值
+		{"contract event", "SetEndBlock", 30, [2]string{"1350", "1350"}},
+	}
+	for _, tt := range tests {
+		args := []interface{}{big.NewInt(2000)}
+		if tt.event == "Claim" {
+			args = []interface{}{testUser, big.NewInt(0), big.NewInt(0)}
+		}
+		l := eventLog(t, task, tt.event, tt.endBlock, "0x01", 0, args...)
+		if err := task.FinishRange(context.Background(), []ethereumTypes.Log{l}, tt.endBlock); err != nil {
+			t.Fatalf("%s: FinishRange() error: %v", tt.name, err)
+		}
+		for poolID, want := range tt.wantPending {
+			stats := getStats(t, task, int32(poolID))
+			if stats.PendingMetanode.String() != want || stats.SettledMetanode.String() != "850" {
+				t.Fatalf("%s: pool %d pending_metanode = %s, settled_metanode = %s, want %s, 850",
+					tt.name, poolID, stats.PendingMetanode, stats.SettledMetanode, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package stake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractstate"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/poolinfo"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/stakeevents"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/unstakerequests"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/userpoolstats"
+)
+
+// Snapshot 在处理区块前保存其可能修改的派生表行，链重组时据此恢复到该区块之前的状态。
+// 资金池和合约参数按合约整体快照，用户统计和解质押请求按日志中涉及的用户快照
+func (t *TaskStake) Snapshot(ctx context.Context, blockNumber uint64, logs []ethereumTypes.Log) error {
+	pools, err := poolinfo.ListByContract(ctx, t.DB, t.ChainID, t.Address)
+	if err != nil {
+		return fmt.Errorf("Snapshot: list pool_info error: %w", err)
+	}
+	if err := t.SaveSnapshot(ctx, blockNumber, model.TableNamePoolInfo, "", pools); err != nil {
+		return err
+	}
+
+	states, err := contractstate.ListByContract(ctx, t.DB, t.ChainID, t.Address)
+	if err != nil {
+		return fmt.Errorf("Snapshot: list stake_contract_state error: %w", err)
+	}
+	if err := t.SaveSnapshot(ctx, blockNumber, model.TableNameStakeContractState, "", states); err != nil {
+		return err
+	}
+
+	for _, user := range t.blockUsers(logs) {
+		stats, err := userpoolstats.ListByUserAndContract(ctx, t.DB, t.ChainID, user, t.Address)
+		if err != nil {
+			return fmt.Errorf("Snapshot: list user_pool_stats error: %w", err)
+		}
+		if err := t.SaveSnapshot(ctx, blockNumber, model.TableNameUserPoolStat, user, stats); err != nil {
+			return err
+		}
+
+		requests, err := unstakerequests.ListByUserAndContract(ctx, t.DB, t.ChainID, user, t.Address)
+		if err != nil {
+			return fmt.Errorf("Snapshot: list user_unstake_requests error: %w", err)
+		}
+		if err := t.SaveSnapshot(ctx, blockNumber, model.TableNameUserUnstakeRequest, user, requests); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore 把派生表恢复为快照内容
+func (t *TaskStake) Restore(ctx context.Context, tx *gorm.DB, s *model.ReorgSnapshot) error {
+	switch s.TargetTable {
+	case model.TableNamePoolInfo:
+		var rows []*model.PoolInfo
+		if err := json.Unmarshal([]byte(s.SnapshotData), &rows); err != nil {
+			return err
+		}
+		return poolinfo.ReplaceByContract(ctx, tx, t.ChainID, t.Address, rows)
+	case model.TableNameStakeContractState:
+		var rows []*model.StakeContractState
+		if err := json.Unmarshal([]byte(s.SnapshotData), &rows); err != nil {
+			return err
+		}
+		return contractstate.ReplaceByContract(ctx, tx, t.ChainID, t.Address, rows)
+	case model.TableNameUserPoolStat:
+		var rows []*model.UserPoolStat
+		if err := json.Unmarshal([]byte(s.SnapshotData), &rows); err != nil {
+			return err
+		}
+		return userpoolstats.ReplaceByUserAndContract(ctx, tx, t.ChainID, s.Scope, t.Address, rows)
+	case model.TableNameUserUnstakeRequest:
+		var rows []*model.UserUnstakeRequest
+		if err := json.Unmarshal([]byte(s.SnapshotData), &rows); err != nil {
+			return err
+		}
+		return unstakerequests.ReplaceByUserAndContract(ctx, tx, t.ChainID, s.Scope, t.Address, rows)
+	default:
+		return fmt.Errorf("unknown table %s", s.TargetTable)
+	}
+}
+
+// Rollback 删除公共祖先之后的 stake 事件表记录和合约参数历史
+func (t *TaskStake) Rollback(ctx context.Context, tx *gorm.DB, ancestor uint64) error {
+	if err := stakeevents.DeleteAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor); err != nil {
+		return err
+	}
+	if err := contractstate.DeleteHistoryAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor); err != nil {
+		return fmt.Errorf("Rollback: delete stake_contract_state_history error: %w", err)
+	}
+	return nil
+}
+
+// Reset 删除合约的资金池、用户统计、解质押请求和合约参数，合约参数在处理第一个区间时重新从部署区块读取
+func (t *TaskStake) Reset(ctx context.Context, tx *gorm.DB) error {
+	if err := poolinfo.DeleteByContract(ctx, tx, t.ChainID, t.Address); err != nil {
+		return fmt.Errorf("Reset: delete pool_info error: %w", err)
+	}
+	if err := userpoolstats.DeleteByContract(ctx, tx, t.ChainID, t.Address); err != nil {
+		return fmt.Errorf("Reset: delete user_pool_stats error: %w", err)
+	}
+	if err := unstakerequests.DeleteByContract(ctx, tx, t.ChainID, t.Address); err != nil {
+		return fmt.Errorf("Reset: delete user_unstake_requests error: %w", err)
+	}
+	if err := contractstate.DeleteByContract(ctx, tx, t.ChainID, t.Address); err != nil {
+		return fmt.Errorf("Reset: delete stake_contract_state error: %w", err)
+	}
+	return nil
+}
+
+// blockUsers 区块日志中涉及的用户（以 user 为第一个 indexed 参数、会修改用户派生表的事件）
+func (t *TaskStake) blockUsers(logs []ethereumTypes.Log) []string {
+	userEvents := map[string]bool{
+		t.ABI.Events["Deposit"].ID.Hex():        true,
+		t.ABI.Events["RequestUnstake"].ID.Hex(): true,
+		t.ABI.Events["Withdraw"].ID.Hex():       true,
+		t.ABI.Events["Claim"].ID.Hex():          true,
+	}
+	var users []string
+	for _, l := range logs {
+		if len(l.Topics) > 1 && userEvents[l.Topics[0].Hex()] {
+			user := topicToAddress(l.Topics[1])
+			if !slices.Contains(users, user) {
+				users = append(users, user)
+			}
+		}
+	}
+	return users
+}
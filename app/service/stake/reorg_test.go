@@ -0,0 +1,30 @@
+package stake
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractstate"
+)
+
+func TestReset(t *testing.T) {
+	task := newTestTaskStake(t)
+	seedContractState(t, task, 10, 1000, "5", 50)
+	seedPool(t, task, 0, "100", "0", "400", 10)
+	seedStats(t, task, 0, "400", "0", "0")
+	seedUnstakeRequest(t, task, testUser, "100", 60, 70)
+	if err := contractstate.SaveHistory(context.Background(), task.DB, 50, getContractState(t, task)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := task.Reset(context.Background(), task.DB); err != nil {
+		t.Fatalf("Reset() error: %v", err)
+	}
+	for _, m := range []interface{}{&model.PoolInfo{}, &model.UserPoolStat{}, &model.UserUnstakeRequest{}, &model.StakeContractState{}, &model.StakeContractStateHistory{}} {
+		var count int64
+		if err := task.DB.Model(m).Count(&count).Error; err != nil || count != 0 {
+			t.Fatalf("%T rows after Reset = %d, %v, want 0", m, count, err)
+		}
+	}
+}
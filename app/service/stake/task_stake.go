@@ -1,245 +1,90 @@
 package stake
 
 import (
-	"context"
-	"errors"
-	"fmt"
-	"math/big"
-	"time"
-
-	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
-	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/contractevents"
-	"github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	ethCommon "github.com/ethereum/go-ethereum/common"
-	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
-
-	"github.com/dijiacoder/MetaNodeStakeSync/app/service/common"
-	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/go-redis/redis/v8"
-	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/threading"
-	"gorm.io/gorm"
-)
-
-// TaskStake stake合约同步任务
-type TaskStake struct {
-	Context      context.Context
-	Config       *config.Config
-	DB           *gorm.DB
-	RedisClient  *redis.Client
-	ChainID      int32
-	ContractName string
-	ABIStr       string
-	Address      string
-	CreatedHash  *string
-	Client       *ethclient.Client
-	ABI          *abi.ABI
-}
-
-// NewTaskStake 创建stake合约同步任务
-func NewTaskStake(serviceCtx *common.ServiceContext) *TaskStake {
-	stakeContract := serviceCtx.ContractInfoMap[1]
-	ABI, err := common.GetABI(stakeContract.ABIStr)
-	if err != nil {
-		logx.Error("Failed to parse ABI: ", err)
-	}
-
-	// 解析ABI
-	for s := range ABI.Events {
-		fmt.Printf("Event: %s, %s\n", s, ABI.Events[s].ID.Hex())
-	}
+	_ "embed"
 
-	return &TaskStake{
-		Context:      serviceCtx.Context,
-		Config:       serviceCtx.Config,
-		DB:           serviceCtx.DB,
-		RedisClient:  serviceCtx.RedisClient,
-		ChainID:      stakeContract.ChainID,
-		ContractName: stakeContract.ContractName,
-		ABIStr:       stakeContract.ABIStr,
-		Address:      stakeContract.Address,
-		CreatedHash:  stakeContract.CreatedHash,
-		Client:       stakeContract.Client,
-		ABI:          ABI,
-	}
-}
-
-func (t *TaskStake) Start() {
-	threading.GoSafe(t.process)
-}
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/indexer"
+)
 
-func (t *TaskStake) process() {
-	for {
-		select {
-		case <-t.Context.Done():
-			logx.Info("stake task stopped")
-			return
-		default:
-			t.queryLogs()
-			time.Sleep(1 * time.Second)
-		}
-	}
-}
+// ContractName chain_contracts.contract_name 中 stake 合约的标识符
+const ContractName int32 = 1
 
-func (t *TaskStake) queryLogs() {
-	startBlock := big.NewInt(0)
+//go:embed migrations/001_raw_units.sql
+var migrationRawUnitsSQL string
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+//go:embed migrations/002_contract_state_history.sql
+var migrationContractStateHistorySQL string
 
-	lastHeigh, err := t.RedisClient.Get(ctx, common.GetKey(t.ChainID, t.Address)).Uint64()
-	if err != nil && !errors.Is(err, redis.Nil) {
-		logx.Info(err)
-	}
+//go:embed migrations/003_chain_id.sql
+var migrationChainIDSQL string
 
-	if lastHeigh == 0 {
-		receipt, err := t.Client.TransactionReceipt(ctx, ethCommon.HexToHash(*t.CreatedHash))
-		if err != nil {
-			logx.Info(err)
-		}
-		startBlock = big.NewInt(0).Add(receipt.BlockNumber, big.NewInt(1))
-	} else {
-		startBlock = big.NewInt(int64(lastHeigh + 1))
-	}
+//go:embed migrations/004_pending_comment.sql
+var migrationPendingCommentSQL string
 
-	currentHeight, err := t.Client.BlockNumber(ctx)
-	if err != nil {
-		logx.Info(err)
-		return
-	}
+//go:embed migrations/005_settled_metanode.sql
+var migrationSettledMetaNodeSQL string
 
-	if big.NewInt(int64(currentHeight)).Cmp(startBlock) <= 0 {
-		return
-	}
+func init() {
+	indexer.Register(module{})
+}
 
-	endBlock := big.NewInt(0).Add(startBlock, big.NewInt(int64(9)))
-	if endBlock.Cmp(big.NewInt(int64(currentHeight))) > 0 {
-		endBlock = big.NewInt(int64(currentHeight))
-	}
+// module stake 合约处理模块
+type module struct{}
 
-	logx.Info(fmt.Sprintf("sync stake, start: %d, end: %d, current: %d", startBlock.Int64(), endBlock.Int64(), currentHeight))
+func (module) ContractName() int32 { return ContractName }
 
-	logs, err := t.Client.FilterLogs(ctx, ethereum.FilterQuery{
-		FromBlock: startBlock,
-		ToBlock:   endBlock,
-		Addresses: []ethCommon.Address{ethCommon.HexToAddress(t.Address)},
-	})
+func (module) Name() string { return "stake" }
 
-	if err != nil {
-		logx.Info(err)
-		return
-	}
+// ABI stake 合约的 ABI 保存在 chain_contracts.abi 中
+func (module) ABI() string { return "" }
 
-	for _, l := range logs {
-		errTx := t.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-			// 在事务内使用 tx，确保所有写入共享同一事务上下文
-			originalDB := t.DB
-			t.DB = tx
-			defer func() { t.DB = originalDB }()
-
-			// 判断交易是否已处理
-			exists, err := t.HasProcessedTx(ctx, l.TxHash.Hex())
-			if err != nil {
-				return err
-			}
-			if exists {
-				logx.Info(fmt.Sprintf("queryLogs: transaction already processed, TxHash=%s", l.TxHash.Hex()))
-				// 已处理则直接结束事务（无写入，正常提交）
-				return nil
-			}
-
-			// 保存统一事件记录（与后续事件处理共享同一事务）
-			if err := t.SaveContractEvent(ctx, l); err != nil {
-				return err
-			}
-
-			handlers := map[string]func(ethereumTypes.Log) error{
-				t.ABI.Events["AddPool"].ID.Hex(): t.HandleAddPoolEvent,
-				//t.ABI.Events["Deposit"].ID.Hex():        t.HandleDepositEvent,
-				//t.ABI.Events["Claim"].ID.Hex():          t.HandleClaimEvent,
-				//t.ABI.Events["RequestUnstake"].ID.Hex(): t.HandleRequestUnstakeEvent,
-				//t.ABI.Events["Withdraw"].ID.Hex():       t.HandleWithdrawEvent,
-			}
-
-			eventID := l.Topics[0].Hex()
-			if h, ok := handlers[eventID]; ok {
-				if err := h(l); err != nil {
-					// 出错直接返回错误，触发事务回滚
-					return fmt.Errorf("failed to handle event: %s, Block: %d, TxHash: %s, Err: %v", eventID, l.BlockNumber, l.TxHash.Hex(), err)
-				}
-			} else {
-				logx.Info(fmt.Sprintf("Unknown event ID: %s, Block: %d, TxHash: %s", eventID, l.BlockNumber, l.TxHash.Hex()))
-			}
-
-			// 正常提交事务
-			return nil
-		})
-
-		if errTx != nil {
-			logx.Error("queryLogs: transaction rollback due to error: ", errTx)
-			continue
-		}
-	}
+// AdminEvents 只能由管理员调用的合约函数（setMetaNodePerBlock、pauseWithdraw 等）触发的事件，用于运维告警订阅
+var AdminEvents = []string{
+	"AddPool", "SetPoolWeight", "UpdatePoolInfo",
+	"SetMetaNode", "SetStartBlock", "SetEndBlock", "SetMetaNodePerBlock",
+	"PauseWithdraw", "UnpauseWithdraw", "PauseClaim", "UnpauseClaim", "Paused", "Unpaused",
+}
 
-	err = t.RedisClient.Set(ctx, common.GetKey(t.ChainID, t.Address), endBlock.Uint64(), 0).Err()
-	if err != nil {
-		logx.Info(err)
-		return
+// Migrations stake 合约的表结构维护在 sql/database_schema.sql 中，这里只包含已有数据库的升级迁移
+func (module) Migrations() []indexer.Migration {
+	return []indexer.Migration{
+		{Version: 1, Description: "store amounts in raw on-chain units", SQL: migrationRawUnitsSQL},
+		{Version: 2, Description: "create stake_contract_state_history", SQL: migrationContractStateHistorySQL},
+		{Version: 3, Description: "add chain_id to stake tables", SQL: migrationChainIDSQL},
+		{Version: 4, Description: "document user_pool_stats.pending_metanode as settled at last user action", SQL: migrationPendingCommentSQL},
+		{Version: 5, Description: "add user_pool_stats.settled_metanode, restore pending_metanode", SQL: migrationSettledMetaNodeSQL},
 	}
 }
 
-func (t *TaskStake) HasProcessedTx(ctx context.Context, txHash string) (bool, error) {
-	return contractevents.ExistsByTxHash(ctx, t.DB, txHash)
+func (module) NewHandler(t *indexer.Task) (indexer.ContractHandler, error) {
+	return &TaskStake{Task: t}, nil
 }
 
-func (t *TaskStake) SaveContractEvent(ctx context.Context, l ethereumTypes.Log) error {
-	// 解析事件名称
-	eventID := l.Topics[0].Hex()
-	eventName := ""
-	for name, ev := range t.ABI.Events {
-		if ev.ID.Hex() == eventID {
-			eventName = name
-			break
-		}
-	}
-
-	// 获取区块时间戳
-	block, err := t.Client.BlockByNumber(ctx, big.NewInt(int64(l.BlockNumber)))
-	if err != nil {
-		return err
-	}
+// TaskStake stake合约事件处理器，t.DB 在处理区间时为同步任务的当前事务
+type TaskStake struct {
+	*indexer.Task
+}
 
-	// 主题与数据
-	var topic1, topic2, topic3 *string
-	if len(l.Topics) > 1 {
-		s := l.Topics[1].Hex()
-		topic1 = &s
-	}
-	if len(l.Topics) > 2 {
-		s := l.Topics[2].Hex()
-		topic2 = &s
-	}
-	if len(l.Topics) > 3 {
-		s := l.Topics[3].Hex()
-		topic3 = &s
-	}
-	dataHex := fmt.Sprintf("0x%x", l.Data)
-
-	// 构建并保存
-	ev := &model.ContractEvent{
-		ContractAddress: t.Address,
-		EventName:       eventName,
-		Topic0:          eventID,
-		Topic1:          topic1,
-		Topic2:          topic2,
-		Topic3:          topic3,
-		Data:            &dataHex,
-		BlockNumber:     l.BlockNumber,
-		BlockTimestamp:  block.Time(),
-		TransactionHash: l.TxHash.Hex(),
-		LogIndex:        int32(l.Index),
+// EventHandlers 按事件名称索引的事件处理函数
+func (t *TaskStake) EventHandlers() map[string]indexer.EventHandler {
+	return map[string]indexer.EventHandler{
+		"AddPool":             {Name: "HandleAddPoolEvent", Handle: t.HandleAddPoolEvent},
+		"Deposit":             {Name: "HandleDepositEvent", Handle: t.HandleDepositEvent},
+		"Claim":               {Name: "HandleClaimEvent", Handle: t.HandleClaimEvent},
+		"RequestUnstake":      {Name: "HandleRequestUnstakeEvent", Handle: t.HandleRequestUnstakeEvent},
+		"Withdraw":            {Name: "HandleWithdrawEvent", Handle: t.HandleWithdrawEvent},
+		"UpdatePoolInfo":      {Name: "HandleUpdatePoolInfoEvent", Handle: t.HandleUpdatePoolInfoEvent},
+		"SetPoolWeight":       {Name: "HandleSetPoolWeightEvent", Handle: t.HandleSetPoolWeightEvent},
+		"UpdatePool":          {Name: "HandleUpdatePoolEvent", Handle: t.HandleUpdatePoolEvent},
+		"SetMetaNode":         {Name: "HandleSetMetaNodeEvent", Handle: t.HandleSetMetaNodeEvent},
+		"SetStartBlock":       {Name: "HandleSetStartBlockEvent", Handle: t.HandleSetStartBlockEvent},
+		"SetEndBlock":         {Name: "HandleSetEndBlockEvent", Handle: t.HandleSetEndBlockEvent},
+		"SetMetaNodePerBlock": {Name: "HandleSetMetaNodePerBlockEvent", Handle: t.HandleSetMetaNodePerBlockEvent},
+		"PauseWithdraw":       {Name: "HandlePauseWithdrawEvent", Handle: t.HandlePauseWithdrawEvent},
+		"UnpauseWithdraw":     {Name: "HandleUnpauseWithdrawEvent", Handle: t.HandleUnpauseWithdrawEvent},
+		"PauseClaim":          {Name: "HandlePauseClaimEvent", Handle: t.HandlePauseClaimEvent},
+		"UnpauseClaim":        {Name: "HandleUnpauseClaimEvent", Handle: t.HandleUnpauseClaimEvent},
+		"Paused":              {Name: "HandlePausedEvent", Handle: t.HandlePausedEvent},
+		"Unpaused":            {Name: "HandleUnpausedEvent", Handle: t.HandleUnpausedEvent},
 	}
-	return contractevents.Create(ctx, t.DB, ev)
 }
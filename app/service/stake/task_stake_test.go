@@ -0,0 +1,376 @@
+package stake
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/indexer"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/rpcpool"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+const (
+	testChainID   = 1
+	testContract  = "0x1111111111111111111111111111111111111111"
+	testTimestamp = 1700000000 // 假节点返回的区块时间戳为 testTimestamp + 区块号
+)
+
+var testUser = ethCommon.HexToAddress("0x2222222222222222222222222222222222222222")
+
+// testStakeABI MetaNodeStake 合约 ABI 中的事件定义和初始化读取的 view 方法（与 sql/base.sql 中 chain_contracts.abi 一致）
+const testStakeABI = `[
+	{"inputs":[],"name":"MetaNode","outputs":[{"name":"","type":"address"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"MetaNodePerBlock","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"claimPaused","outputs":[{"name":"","type":"bool"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"endBlock","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"paused","outputs":[{"name":"","type":"bool"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"startBlock","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+	{"inputs":[],"name":"withdrawPaused","outputs":[{"name":"","type":"bool"}],"stateMutability":"view","type":"function"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"stTokenAddress","type":"address"},{"indexed":true,"name":"poolWeight","type":"uint256"},{"indexed":true,"name":"lastRewardBlock","type":"uint256"},{"indexed":false,"name":"minDepositAmount","type":"uint256"},{"indexed":false,"name":"unstakeLockedBlocks","type":"uint256"}],"name":"AddPool","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"user","type":"address"},{"indexed":true,"name":"poolId","type":"uint256"},{"indexed":false,"name":"MetaNodeReward","type":"uint256"}],"name":"Claim","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"user","type":"address"},{"indexed":true,"name":"poolId","type":"uint256"},{"indexed":false,"name":"amount","type":"uint256"}],"name":"Deposit","type":"event"},
+	{"anonymous":false,"inputs":[],"name":"PauseClaim","type":"event"},
+	{"anonymous":false,"inputs":[],"name":"PauseWithdraw","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"account","type":"address"}],"name":"Paused","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"user","type":"address"},{"indexed":true,"name":"poolId","type":"uint256"},{"indexed":false,"name":"amount","type":"uint256"}],"name":"RequestUnstake","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"endBlock","type":"uint256"}],"name":"SetEndBlock","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"MetaNode","type":"address"}],"name":"SetMetaNode","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"MetaNodePerBlock","type":"uint256"}],"name":"SetMetaNodePerBlock","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"poolId","type":"uint256"},{"indexed":true,"name":"poolWeight","type":"uint256"},{"indexed":false,"name":"totalPoolWeight","type":"uint256"}],"name":"SetPoolWeight","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"startBlock","type":"uint256"}],"name":"SetStartBlock","type":"event"},
+	{"anonymous":false,"inputs":[],"name":"UnpauseClaim","type":"event"},
+	{"anonymous":false,"inputs":[],"name":"UnpauseWithdraw","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":false,"name":"account","type":"address"}],"name":"Unpaused","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"poolId","type":"uint256"},{"indexed":true,"name":"lastRewardBlock","type":"uint256"},{"indexed":false,"name":"totalMetaNode","type":"uint256"}],"name":"UpdatePool","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"poolId","type":"uint256"},{"indexed":true,"name":"minDepositAmount","type":"uint256"},{"indexed":true,"name":"unstakeLockedBlocks","type":"uint256"}],"name":"UpdatePoolInfo","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"name":"user","type":"address"},{"indexed":true,"name":"poolId","type":"uint256"},{"indexed":false,"name":"amount","type":"uint256"},{"indexed":true,"name":"blockNumber","type":"uint256"}],"name":"Withdraw","type":"event"}
+]`
+
+// 金额列使用 TEXT，避免 sqlite 把大整数转为浮点数
+var testSchema = []string{
+	`CREATE TABLE pool_info (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, pool_id INT, contract_address TEXT, st_token_address TEXT,
+		pool_weight TEXT, last_reward_block INT, acc_metanode_per_st TEXT DEFAULT '0', st_token_amount TEXT DEFAULT '0', min_deposit_amount TEXT,
+		unstake_locked_blocks INT, total_pool_weight TEXT, is_active BOOLEAN DEFAULT 1, created_block INT, created_tx TEXT,
+		created_at TIMESTAMP, updated_at TIMESTAMP, UNIQUE (chain_id, pool_id, contract_address))`,
+	`CREATE TABLE user_pool_stats (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, user_address TEXT, pool_id INT, contract_address TEXT,
+		st_amount TEXT DEFAULT '0', finished_metanode TEXT DEFAULT '0', pending_metanode TEXT DEFAULT '0', settled_metanode TEXT DEFAULT '0', total_deposited TEXT DEFAULT '0',
+		total_unstaked TEXT DEFAULT '0', total_withdrawn TEXT DEFAULT '0', total_claimed TEXT DEFAULT '0', last_deposit_block INT,
+		last_claim_block INT, created_at TIMESTAMP, updated_at TIMESTAMP, UNIQUE (chain_id, user_address, pool_id, contract_address))`,
+	`CREATE TABLE event_request_unstake (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, user_address TEXT,
+		pool_id INT, amount TEXT, block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP,
+		UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE user_unstake_requests (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, user_address TEXT, pool_id INT,
+		contract_address TEXT, amount TEXT, unlock_block INT, request_block INT, request_tx TEXT, is_withdrawn BOOLEAN DEFAULT 0,
+		withdrawn_block INT, withdrawn_tx TEXT, created_at TIMESTAMP, updated_at TIMESTAMP)`,
+	`CREATE TABLE event_update_pool_info (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, pool_id INT,
+		min_deposit_amount TEXT, unstake_locked_blocks INT, block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT,
+		created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_set_pool_weight (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, pool_id INT,
+		pool_weight TEXT, total_pool_weight TEXT, block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT,
+		created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_update_pool (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, pool_id INT,
+		last_reward_block INT, total_metanode TEXT, block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT,
+		created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_deposit (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, user_address TEXT,
+		pool_id INT, amount TEXT, block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP,
+		UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_withdraw (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, user_address TEXT,
+		pool_id INT, amount TEXT, withdraw_block_number INT, matched_amount TEXT NOT NULL DEFAULT '0', amount_mismatch BOOLEAN NOT NULL DEFAULT FALSE,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_claim (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, user_address TEXT,
+		pool_id INT, metanode_reward TEXT, block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP,
+		UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE stake_contract_state (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, metanode_token TEXT,
+		start_block INT, end_block INT, metanode_per_block TEXT, withdraw_paused BOOLEAN NOT NULL DEFAULT FALSE,
+		claim_paused BOOLEAN NOT NULL DEFAULT FALSE, paused BOOLEAN NOT NULL DEFAULT FALSE, last_updated_block INT,
+		created_at TIMESTAMP, updated_at TIMESTAMP, UNIQUE (chain_id, contract_address))`,
+	`CREATE TABLE stake_contract_state_history (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, block_number INT,
+		metanode_token TEXT, start_block INT, end_block INT, metanode_per_block TEXT, withdraw_paused BOOLEAN NOT NULL DEFAULT FALSE,
+		claim_paused BOOLEAN NOT NULL DEFAULT FALSE, paused BOOLEAN NOT NULL DEFAULT FALSE, created_at TIMESTAMP, updated_at TIMESTAMP,
+		UNIQUE (chain_id, contract_address, block_number))`,
+	`CREATE TABLE event_set_metanode (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, metanode_token TEXT,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_set_start_block (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, start_block INT,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_set_end_block (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, end_block INT,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_set_metanode_per_block (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, metanode_per_block TEXT,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_pause_withdraw (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, is_paused BOOLEAN NOT NULL,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_pause_claim (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, is_paused BOOLEAN NOT NULL,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+	`CREATE TABLE event_paused (id INTEGER PRIMARY KEY AUTOINCREMENT, chain_id INT, contract_address TEXT, is_paused BOOLEAN NOT NULL, account TEXT,
+		block_number INT, block_timestamp INT, transaction_hash TEXT, log_index INT, created_at TIMESTAMP, UNIQUE (chain_id, transaction_hash, log_index))`,
+}
+
+// testNode 假节点的链上数据
+type testNode struct {
+	deployBlock  uint64                 // 合约创建交易回执所在的区块
+	latest       uint64                 // latest 对应的区块号
+	prunedBefore uint64                 // 早于该区块的 eth_call 返回 missing trie node，模拟非归档节点
+	state        map[string]interface{} // eth_call 的 view 方法名 -> 返回值
+}
+
+// newTestNode 响应 handler 所需 JSON-RPC 请求的假节点：eth_getBlockByNumber 返回时间戳为 testTimestamp + 区块号的区块头，
+// eth_getTransactionReceipt 返回部署区块的回执，eth_call 按 ABI 编码 node.state 中的返回值
+func newTestNode(t *testing.T, node *testNode, contractABI *abi.ABI) *rpcpool.Pool {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Params) == 0 {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		// blockNumber 解析区块参数，latest 返回 node.latest
+		blockNumber := func(raw json.RawMessage) (uint64, error) {
+			if string(raw) == `"latest"` {
+				return node.latest, nil
+			}
+			var number hexutil.Uint64
+			err := json.Unmarshal(raw, &number)
+			return uint64(number), err
+		}
+		reply := func(result interface{}) {
+			data, _ := json.Marshal(result)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, data)
+		}
+
+		switch req.Method {
+		case "eth_getBlockByNumber":
+			number, err := blockNumber(req.Params[0])
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			reply(&ethereumTypes.Header{
+				Number:     new(big.Int).SetUint64(number),
+				Difficulty: big.NewInt(0),
+				Time:       testTimestamp + number,
+			})
+		case "eth_getTransactionReceipt":
+			var txHash ethCommon.Hash
+			if err := json.Unmarshal(req.Params[0], &txHash); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			reply(&ethereumTypes.Receipt{
+				Status:      ethereumTypes.ReceiptStatusSuccessful,
+				Logs:        []*ethereumTypes.Log{},
+				TxHash:      txHash,
+				BlockNumber: new(big.Int).SetUint64(node.deployBlock),
+			})
+		case "eth_call":
+			var msg struct {
+				Input hexutil.Bytes `json:"input"`
+			}
+			if len(req.Params) < 2 || json.Unmarshal(req.Params[0], &msg) != nil || len(msg.Input) < 4 {
+				http.Error(w, "bad eth_call", http.StatusBadRequest)
+				return
+			}
+			number, err := blockNumber(req.Params[1])
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if number < node.prunedBefore {
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"error":{"code":-32000,"message":"missing trie node 1a2b3c (path )"}}`, req.ID)
+				return
+			}
+			method, err := contractABI.MethodById(msg.Input[:4])
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			out, err := method.Outputs.Pack(node.state[method.Name])
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			reply(hexutil.Bytes(out))
+		default:
+			http.Error(w, "unsupported request", http.StatusBadRequest)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	pool, err := rpcpool.New(testChainID, []rpcpool.Endpoint{{URL: srv.URL}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pool
+}
+
+func newTestTaskStake(t *testing.T) *TaskStake {
+	return newTestTaskStakeWithNode(t, &testNode{})
+}
+
+// newTestTaskStakeWithNode 创建连接到 node 的任务，合约创建交易回执由 node.deployBlock 给出
+func newTestTaskStakeWithNode(t *testing.T, node *testNode) *TaskStake {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range testSchema {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	contractABI, err := abi.JSON(strings.NewReader(testStakeABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	createdHash := ethCommon.HexToHash("0xc0").Hex()
+	return &TaskStake{Task: &indexer.Task{
+		DB:          db,
+		ChainID:     testChainID,
+		Address:     testContract,
+		CreatedHash: &createdHash,
+		ABI:         &contractABI,
+		Client:      newTestNode(t, node, &contractABI),
+		Module:      module{},
+	}}
+}
+
+// eventLog 按 ABI 把参数编码为事件日志：indexed 参数写入 topics，其余参数写入 data，args 按事件定义的顺序给出
+func eventLog(t *testing.T, task *TaskStake, name string, block uint64, txHash string, index uint, args ...interface{}) ethereumTypes.Log {
+	ev, ok := task.ABI.Events[name]
+	if !ok {
+		t.Fatalf("event %s not in ABI", name)
+	}
+	if len(args) != len(ev.Inputs) {
+		t.Fatalf("event %s has %d inputs, got %d args", name, len(ev.Inputs), len(args))
+	}
+	topics := []ethCommon.Hash{ev.ID}
+	var data []interface{}
+	for i, input := range ev.Inputs {
+		if !input.Indexed {
+			data = append(data, args[i])
+			continue
+		}
+		switch v := args[i].(type) {
+		case ethCommon.Address:
+			topics = append(topics, ethCommon.BytesToHash(v.Bytes()))
+		case *big.Int:
+			topics = append(topics, ethCommon.BigToHash(v))
+		default:
+			t.Fatalf("event %s: unsupported indexed arg %T", name, v)
+		}
+	}
+	packed, err := ev.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ethereumTypes.Log{
+		Address:     ethCommon.HexToAddress(testContract),
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: block,
+		TxHash:      ethCommon.HexToHash(txHash),
+		Index:       index,
+	}
+}
+
+// seedPool 写入资金池，金额参数为十进制字符串
+func seedPool(t *testing.T, task *TaskStake, poolID int32, poolWeight, accMetaNodePerST, stTokenAmount string, unstakeLockedBlocks int32) {
+	if err := task.DB.Exec(`INSERT INTO pool_info (chain_id, pool_id, contract_address, st_token_address, pool_weight, last_reward_block,
+		acc_metanode_per_st, st_token_amount, min_deposit_amount, unstake_locked_blocks, total_pool_weight)
+		VALUES (?, ?, ?, ?, ?, 0, ?, ?, '0', ?, ?)`,
+		testChainID, poolID, testContract, ethCommon.Address{}.Hex(), poolWeight, accMetaNodePerST, stTokenAmount, unstakeLockedBlocks, poolWeight).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+// seedStats 写入 testUser 在资金池中的质押和奖励状态，pending 为上次操作时结算的值
+func seedStats(t *testing.T, task *TaskStake, poolID int32, stAmount, finished, pending string) {
+	if err := task.DB.Exec(`INSERT INTO user_pool_stats (chain_id, user_address, pool_id, contract_address, st_amount, finished_metanode, pending_metanode, settled_metanode)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`, testChainID, testUser.Hex(), poolID, testContract, stAmount, finished, pending, pending).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getPool(t *testing.T, task *TaskStake, poolID int32) *model.PoolInfo {
+	var pool model.PoolInfo
+	if err := task.DB.Where("pool_id = ?", poolID).First(&pool).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &pool
+}
+
+func getStats(t *testing.T, task *TaskStake, poolID int32) *model.UserPoolStat {
+	var stats model.UserPoolStat
+	if err := task.DB.Where("user_address = ? AND pool_id = ?", testUser.Hex(), poolID).First(&stats).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &stats
+}
+
+// seedContractState 写入合约参数，lastUpdatedBlock 之前的参数事件不再修改状态
+func seedContractState(t *testing.T, task *TaskStake, startBlock, endBlock uint64, perBlock string, lastUpdatedBlock uint64) {
+	if err := task.DB.Exec(`INSERT INTO stake_contract_state (chain_id, contract_address, metanode_token, start_block, end_block,
+		metanode_per_block, last_updated_block) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		testChainID, testContract, ethCommon.Address{}.Hex(), startBlock, endBlock, perBlock, lastUpdatedBlock).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func getContractState(t *testing.T, task *TaskStake) *model.StakeContractState {
+	var state model.StakeContractState
+	if err := task.DB.Where("chain_id = ? AND contract_address = ?", testChainID, testContract).First(&state).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &state
+}
+
+// contractStateHistory 返回按区块号排列的参数历史
+func contractStateHistory(t *testing.T, task *TaskStake) []*model.StakeContractStateHistory {
+	var rows []*model.StakeContractStateHistory
+	if err := task.DB.Order("block_number").Find(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+// stateParams 把合约参数格式化为 "token start end perBlock withdrawPaused claimPaused paused"
+func stateParams(s *model.StakeContractState) string {
+	return fmt.Sprintf("%s %d %d %s %v %v %v", *s.MetanodeToken, *s.StartBlock, *s.EndBlock, s.MetanodePerBlock.String(), s.WithdrawPaused, s.ClaimPaused, s.Paused)
+}
+
+// historyParams 按 stateParams 的格式输出参数历史
+func historyParams(h *model.StakeContractStateHistory) string {
+	return fmt.Sprintf("%s %d %d %s %v %v %v", *h.MetanodeToken, *h.StartBlock, *h.EndBlock, h.MetanodePerBlock.String(), h.WithdrawPaused, h.ClaimPaused, h.Paused)
+}
+
+// checkContractState 校验 block 区块的参数事件后 stake_contract_state 和参数历史的最后一条记录都等于 want
+func checkContractState(t *testing.T, task *TaskStake, block uint64, want string) {
+	t.Helper()
+	state := getContractState(t, task)
+	if got := stateParams(state); got != want || state.LastUpdatedBlock == nil || *state.LastUpdatedBlock != block {
+		t.Fatalf("stake_contract_state = %s at %v, want %s at %d", got, state.LastUpdatedBlock, want, block)
+	}
+	history := contractStateHistory(t, task)
+	if len(history) == 0 {
+		t.Fatal("stake_contract_state_history is empty")
+	}
+	if last := history[len(history)-1]; last.BlockNumber != block || historyParams(last) != want {
+		t.Fatalf("last stake_contract_state_history = %s at %d, want %s at %d", historyParams(last), last.BlockNumber, want, block)
+	}
+}
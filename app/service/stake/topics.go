@@ -0,0 +1,60 @@
+package stake
+
+import (
+	"math/big"
+	"sort"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// EventParams 事件中用户地址和资金池ID所在的 topic 位置（contract_events.topic1/topic2），0 表示事件不含该参数
+type EventParams struct {
+	User int
+	Pool int
+}
+
+// IndexedEventParams 带用户或资金池索引参数的事件，与 MetaNodeStake.sol 的事件定义一致
+var IndexedEventParams = map[string]EventParams{
+	"Deposit":        {User: 1, Pool: 2},
+	"Claim":          {User: 1, Pool: 2},
+	"RequestUnstake": {User: 1, Pool: 2},
+	"Withdraw":       {User: 1, Pool: 2},
+	"UpdatePool":     {Pool: 1},
+	"UpdatePoolInfo": {Pool: 1},
+	"SetPoolWeight":  {Pool: 1},
+}
+
+// EventsWithParams 按 topic 位置分组返回含有所需参数的事件名称（组内已排序），用于按用户或资金池过滤 contract_events
+func EventsWithParams(needUser, needPool bool) map[EventParams][]string {
+	res := make(map[EventParams][]string)
+	for name, params := range IndexedEventParams {
+		if (needUser && params.User == 0) || (needPool && params.Pool == 0) {
+			continue
+		}
+		res[params] = append(res[params], name)
+	}
+	for _, names := range res {
+		sort.Strings(names)
+	}
+	return res
+}
+
+// AddressTopic 地址作为 indexed 参数时在 contract_events 中保存的 topic 值
+func AddressTopic(address string) string {
+	return ethCommon.BytesToHash(ethCommon.HexToAddress(address).Bytes()).Hex()
+}
+
+// PoolTopic 资金池ID作为 indexed 参数时在 contract_events 中保存的 topic 值
+func PoolTopic(poolID int32) string {
+	return ethCommon.BigToHash(big.NewInt(int64(poolID))).Hex()
+}
+
+// TopicToAddress 把 contract_events 中的 topic 值解析为地址
+func TopicToAddress(topic string) string {
+	return topicToAddress(ethCommon.HexToHash(topic))
+}
+
+// TopicToPoolID 把 contract_events 中的 topic 值解析为资金池ID
+func TopicToPoolID(topic string) int32 {
+	return int32(ethCommon.HexToHash(topic).Big().Int64())
+}
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/tokenbalances"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/tokentransfers"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+// zeroAddress 铸造的转出地址和销毁的转入地址，不记录余额
+var zeroAddress = ethCommon.Address{}.Hex()
+
+func (t *TaskToken) HandleTransferEvent(l ethereumTypes.Log) error {
+	// 校验topics长度（topic0签名 + from、to两个indexed参数）
+	if len(l.Topics) < 3 {
+		return fmt.Errorf("HandleTransferEvent: invalid topics length, tx=%s", l.TxHash.Hex())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	from := topicToAddress(l.Topics[1])
+	to := topicToAddress(l.Topics[2])
+
+	params, err := t.ABI.Events["Transfer"].Inputs.UnpackValues(l.Data)
+	if err != nil {
+		return fmt.Errorf("HandleTransferEvent: unpack data error: %w", err)
+	}
+	if len(params) < 1 {
+		return fmt.Errorf("HandleTransferEvent: invalid params length")
+	}
+	amount := types.NewBigInt(params[0].(*big.Int))
+
+	blockTimestamp, err := t.GetBlockTimestamp(ctx, l.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("HandleTransferEvent: get block error: %w", err)
+	}
+
+	if err := tokentransfers.Create(ctx, t.DB, &model.TokenTransfer{
+		ChainID:         t.ChainID,
+		ContractAddress: t.Address,
+		FromAddress:     from,
+		ToAddress:       to,
+		Amount:          amount,
+		BlockNumber:     l.BlockNumber,
+		BlockTimestamp:  blockTimestamp,
+		TransactionHash: l.TxHash.Hex(),
+		LogIndex:        int32(l.Index),
+	}); err != nil {
+		return fmt.Errorf("HandleTransferEvent: create token_transfers error: %w", err)
+	}
+
+	if from != zeroAddress {
+		if err := tokenbalances.AddBalance(ctx, t.DB, t.ChainID, t.Address, from, amount.Neg(), l.BlockNumber); err != nil {
+			return fmt.Errorf("HandleTransferEvent: update balance of %s error: %w", from, err)
+		}
+	}
+	if to != zeroAddress {
+		if err := tokenbalances.AddBalance(ctx, t.DB, t.ChainID, t.Address, to, amount, l.BlockNumber); err != nil {
+			return fmt.Errorf("HandleTransferEvent: update balance of %s error: %w", to, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/indexer"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/rpcpool"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+const (
+	testContract  = "0x1111111111111111111111111111111111111111"
+	testTimestamp = 1700000000 // 假节点返回的区块时间戳为 testTimestamp + 区块号
+)
+
+var (
+	holderA = ethCommon.HexToAddress("0xaaaa000000000000000000000000000000000001")
+	holderB = ethCommon.HexToAddress("0xbbbb000000000000000000000000000000000002")
+	holderC = ethCommon.HexToAddress("0xcccc000000000000000000000000000000000003")
+)
+
+// newHeaderNode 只响应 eth_getBlockByNumber 的假节点，供 handler 读取区块时间戳
+func newHeaderNode(t *testing.T) *rpcpool.Pool {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "eth_getBlockByNumber" {
+			http.Error(w, "unsupported request", http.StatusBadRequest)
+			return
+		}
+		var number hexutil.Uint64
+		if err := json.Unmarshal(req.Params[0], &number); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		header, _ := json.Marshal(&ethereumTypes.Header{
+			Number:     new(big.Int).SetUint64(uint64(number)),
+			Difficulty: big.NewInt(0),
+			Time:       testTimestamp + uint64(number),
+		})
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, header)
+	}))
+	t.Cleanup(srv.Close)
+
+	pool, err := rpcpool.New(1, []rpcpool.Endpoint{{URL: srv.URL}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pool
+}
+
+func newTestTaskToken(t *testing.T) *TaskToken {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ddl := range []string{
+		`CREATE TABLE token_transfers (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			from_address VARCHAR(42) NOT NULL,
+			to_address VARCHAR(42) NOT NULL,
+			amount DECIMAL(65,0) NOT NULL,
+			block_number BIGINT NOT NULL,
+			block_timestamp BIGINT NOT NULL,
+			transaction_hash VARCHAR(66) NOT NULL,
+			log_index INT NOT NULL,
+			created_at TIMESTAMP,
+			UNIQUE (chain_id, transaction_hash, log_index)
+		)`,
+		`CREATE TABLE token_balances (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			holder_address VARCHAR(42) NOT NULL,
+			balance DECIMAL(65,0) NOT NULL DEFAULT 0,
+			last_updated_block BIGINT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE (chain_id, contract_address, holder_address)
+		)`,
+		`CREATE TABLE reorg_snapshots (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			chain_id INT NOT NULL,
+			contract_address VARCHAR(42) NOT NULL,
+			block_number BIGINT NOT NULL,
+			target_table VARCHAR(64) NOT NULL,
+			scope VARCHAR(42) NOT NULL,
+			snapshot_data TEXT NOT NULL,
+			created_at TIMESTAMP,
+			UNIQUE (chain_id, contract_address, block_number, target_table, scope)
+		)`,
+	} {
+		if err := db.Exec(ddl).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(erc20ABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &TaskToken{Task: &indexer.Task{
+		DB:      db,
+		ChainID: 1,
+		Address: testContract,
+		ABI:     &contractABI,
+		Client:  newHeaderNode(t),
+		Module:  module{},
+	}}
+}
+
+// transferLog 按 ABI 编码 Transfer(from, to, value) 日志
+func transferLog(t *testing.T, task *TaskToken, block uint64, txHash string, index uint, from, to ethCommon.Address, value int64) ethereumTypes.Log {
+	ev := task.ABI.Events["Transfer"]
+	data, err := ev.Inputs.NonIndexed().Pack(big.NewInt(value))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ethereumTypes.Log{
+		Address:     ethCommon.HexToAddress(testContract),
+		Topics:      []ethCommon.Hash{ev.ID, ethCommon.BytesToHash(from.Bytes()), ethCommon.BytesToHash(to.Bytes())},
+		Data:        data,
+		BlockNumber: block,
+		TxHash:      ethCommon.HexToHash(txHash),
+		Index:       index,
+	}
+}
+
+// balances 返回持有人地址到余额的映射
+func balances(t *testing.T, task *TaskToken) map[string]string {
+	var rows []*model.TokenBalance
+	if err := task.DB.Find(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+	res := make(map[string]string, len(rows))
+	for _, row := range rows {
+		res[row.HolderAddress] = row.Balance.String()
+	}
+	return res
+}
+
+func TestHandleTransferEvent(t *testing.T) {
+	zero := ethCommon.Address{}
+	tests := []struct {
+		name     string
+		from, to ethCommon.Address
+		value    int64
+		want     map[string]string
+	}{
+		// 铸造只增加转入地址的余额，不记录零地址
+		{"mint", zero, holderA, 1000, map[string]string{holderA.Hex(): "1000"}},
+		{"transfer", holderA, holderB, 300, map[string]string{holderA.Hex(): "700", holderB.Hex(): "300"}},
+		{"burn", holderB, zero, 100, map[string]string{holderA.Hex(): "700", holderB.Hex(): "200"}},
+	}
+
+	task := newTestTaskToken(t)
+	for i, tt := range tests {
+		block := uint64(10 + i)
+		txHash := fmt.Sprintf("0x%02x", i+1)
+		if err := task.HandleTransferEvent(transferLog(t, task, block, txHash, 3, tt.from, tt.to, tt.value)); err != nil {
+			t.Fatalf("%s: HandleTransferEvent() error: %v", tt.name, err)
+		}
+
+		var transfer model.TokenTransfer
+		if err := task.DB.Where("transaction_hash = ?", ethCommon.HexToHash(txHash).Hex()).First(&transfer).Error; err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if transfer.FromAddress != tt.from.Hex() || transfer.ToAddress != tt.to.Hex() || transfer.Amount.String() != fmt.Sprint(tt.value) ||
+			transfer.BlockNumber != block || transfer.BlockTimestamp != testTimestamp+block || transfer.LogIndex != 3 {
+			t.Fatalf("%s: transfer = %+v", tt.name, transfer)
+		}
+		if got := balances(t, task); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Fatalf("%s: balances = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// topics 不完整的日志不写入任何数据
+	l := transferLog(t, task, 20, "0x10", 0, holderA, holderB, 1)
+	l.Topics = l.Topics[:2]
+	if err := task.HandleTransferEvent(l); err == nil {
+		t.Fatal("HandleTransferEvent() with 2 topics succeeded, want error")
+	}
+}
@@ -0,0 +1,35 @@
+-- ========================================
+-- ERC20 Transfer 事件表
+-- ========================================
+CREATE TABLE IF NOT EXISTS token_transfers (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    chain_id INT NOT NULL COMMENT '链ID',
+    contract_address VARCHAR(42) NOT NULL COMMENT '代币合约地址',
+    from_address VARCHAR(42) NOT NULL COMMENT '转出地址 (0x0 表示铸造)',
+    to_address VARCHAR(42) NOT NULL COMMENT '转入地址 (0x0 表示销毁)',
+    amount DECIMAL(65,18) NOT NULL COMMENT '转账金额',
+    block_number BIGINT UNSIGNED NOT NULL,
+    block_timestamp BIGINT UNSIGNED NOT NULL,
+    transaction_hash VARCHAR(66) NOT NULL,
+    log_index INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    INDEX idx_contract_block (contract_address, block_number),
+    INDEX idx_from (from_address),
+    INDEX idx_to (to_address)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='ERC20 Transfer 事件表';
+
+-- ========================================
+-- ERC20 持有人余额表
+-- ========================================
+CREATE TABLE IF NOT EXISTS token_balances (
+    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+    chain_id INT NOT NULL COMMENT '链ID',
+    contract_address VARCHAR(42) NOT NULL COMMENT '代币合约地址',
+    holder_address VARCHAR(42) NOT NULL COMMENT '持有人地址',
+    balance DECIMAL(65,18) NOT NULL DEFAULT 0 COMMENT '当前余额',
+    last_updated_block BIGINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '最后变动区块',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_contract_holder (contract_address, holder_address)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='ERC20 持有人余额表';
@@ -0,0 +1,23 @@
+-- ========================================
+-- 金额列改为按代币原始最小单位存储：DECIMAL(65,18) 的代币单位 -> DECIMAL(65,0) 的整数
+-- 每张表先把已有数据乘以 10^18 再修改列类型；UPDATE 以列的小数位数为条件，中途失败后重跑不会重复换算
+-- ========================================
+
+UPDATE token_transfers SET
+    amount = amount * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'token_transfers' AND COLUMN_NAME = 'amount') = 18;
+
+ALTER TABLE token_transfers
+    MODIFY amount DECIMAL(65,0) NOT NULL COMMENT '转账金额';
+
+UPDATE token_balances SET
+    balance = balance * 1000000000000000000
+WHERE (SELECT NUMERIC_SCALE FROM information_schema.COLUMNS
+       WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'token_balances' AND COLUMN_NAME = 'balance') = 18;
+
+ALTER TABLE token_balances
+    MODIFY balance DECIMAL(65,0) NOT NULL DEFAULT 0 COMMENT '当前余额';
+
+-- 快照中的余额仍为代币单位，无法按新格式恢复
+DELETE FROM reorg_snapshots WHERE target_table = 'token_balances';
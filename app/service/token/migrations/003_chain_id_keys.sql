@@ -0,0 +1,8 @@
+-- ========================================
+-- token_transfers / token_balances 已有 chain_id 列，唯一键加上 chain_id 后
+-- 不同链上地址相同的代币合约各自记录转账和余额。DROP INDEX 与 ADD UNIQUE KEY 在同一语句中执行，可重复执行
+-- ========================================
+
+ALTER TABLE token_transfers DROP INDEX uk_tx_log, ADD UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index);
+
+ALTER TABLE token_balances DROP INDEX uk_contract_holder, ADD UNIQUE KEY uk_contract_holder (chain_id, contract_address, holder_address);
@@ -0,0 +1,65 @@
+package token
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/tokenbalances"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/tokentransfers"
+)
+
+// Snapshot 在处理区块前保存区块内 Transfer 涉及地址的余额，链重组时据此恢复
+func (t *TaskToken) Snapshot(ctx context.Context, blockNumber uint64, logs []ethereumTypes.Log) error {
+	transferID := t.ABI.Events["Transfer"].ID
+	var holders []string
+	for _, l := range logs {
+		if len(l.Topics) < 3 || l.Topics[0] != transferID {
+			continue
+		}
+		for _, topic := range l.Topics[1:3] {
+			holder := topicToAddress(topic)
+			if holder != zeroAddress && !slices.Contains(holders, holder) {
+				holders = append(holders, holder)
+			}
+		}
+	}
+
+	for _, holder := range holders {
+		balances, err := tokenbalances.ListByHolderAndContract(ctx, t.DB, t.ChainID, holder, t.Address)
+		if err != nil {
+			return fmt.Errorf("Snapshot: list token_balances error: %w", err)
+		}
+		if err := t.SaveSnapshot(ctx, blockNumber, model.TableNameTokenBalance, holder, balances); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Restore 把持有人余额恢复为快照内容
+func (t *TaskToken) Restore(ctx context.Context, tx *gorm.DB, s *model.ReorgSnapshot) error {
+	if s.TargetTable != model.TableNameTokenBalance {
+		return fmt.Errorf("unknown table %s", s.TargetTable)
+	}
+	var rows []*model.TokenBalance
+	if err := json.Unmarshal([]byte(s.SnapshotData), &rows); err != nil {
+		return err
+	}
+	return tokenbalances.ReplaceByHolderAndContract(ctx, tx, t.ChainID, s.Scope, t.Address, rows)
+}
+
+// Rollback 删除公共祖先之后的转账记录
+func (t *TaskToken) Rollback(ctx context.Context, tx *gorm.DB, ancestor uint64) error {
+	return tokentransfers.DeleteAfterBlock(ctx, tx, t.ChainID, t.Address, ancestor)
+}
+
+// Reset 删除合约下全部持有人余额
+func (t *TaskToken) Reset(ctx context.Context, tx *gorm.DB) error {
+	return tokenbalances.DeleteByContract(ctx, tx, t.ChainID, t.Address)
+}
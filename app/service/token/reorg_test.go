@@ -0,0 +1,92 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/reorgsnapshots"
+)
+
+func TestReorgRestore(t *testing.T) {
+	ctx := context.Background()
+	task := newTestTaskToken(t)
+
+	// 与同步任务相同：处理区块的第一条日志前保存快照，再依次处理区块内的日志
+	apply := func(block uint64, logs ...ethereumTypes.Log) {
+		if err := task.Snapshot(ctx, block, logs); err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range logs {
+			if err := task.HandleTransferEvent(l); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	apply(10, transferLog(t, task, 10, "0x01", 0, ethCommon.Address{}, holderA, 1000))
+	apply(11,
+		transferLog(t, task, 11, "0x02", 0, holderA, holderB, 300),
+		transferLog(t, task, 11, "0x02", 1, holderB, holderC, 100),
+	)
+	apply(12, transferLog(t, task, 12, "0x03", 0, holderA, holderB, 50))
+
+	want := map[string]string{holderA.Hex(): "650", holderB.Hex(): "250", holderC.Hex(): "100"}
+	if got := balances(t, task); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("balances before reorg = %v, want %v", got, want)
+	}
+
+	// 回滚到区块 10：与 indexer 的 rollbackTo 相同，每个持有人只使用最早的快照，再删除之后的转账
+	snapshots, err := reorgsnapshots.ListAfterBlock(ctx, task.DB, task.ChainID, task.Address, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := make(map[string]bool)
+	for _, s := range snapshots {
+		if s.TargetTable != model.TableNameTokenBalance {
+			t.Fatalf("snapshot of unexpected table %s", s.TargetTable)
+		}
+		if restored[s.Scope] {
+			continue
+		}
+		restored[s.Scope] = true
+		if err := task.Restore(ctx, task.DB, s); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := task.Rollback(ctx, task.DB, 10); err != nil {
+		t.Fatal(err)
+	}
+
+	// 区块 11 之前不存在的持有人记录被删除，零地址从未被快照
+	want = map[string]string{holderA.Hex(): "1000"}
+	if got := balances(t, task); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("balances after reorg = %v, want %v", got, want)
+	}
+	if len(restored) != 3 {
+		t.Fatalf("restored %d holders, want 3", len(restored))
+	}
+	var transfers []*model.TokenTransfer
+	if err := task.DB.Find(&transfers).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(transfers) != 1 || transfers[0].BlockNumber != 10 {
+		t.Fatalf("token_transfers after reorg = %d rows, want only block 10", len(transfers))
+	}
+}
+
+func TestReset(t *testing.T) {
+	task := newTestTaskToken(t)
+	if err := task.HandleTransferEvent(transferLog(t, task, 10, "0x01", 0, ethCommon.Address{}, holderA, 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := task.Reset(context.Background(), task.DB); err != nil {
+		t.Fatalf("Reset() error: %v", err)
+	}
+	if got := balances(t, task); len(got) != 0 {
+		t.Fatalf("balances after Reset = %v, want none", got)
+	}
+}
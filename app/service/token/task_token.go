@@ -0,0 +1,60 @@
+package token
+
+import (
+	_ "embed"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/indexer"
+)
+
+// ContractName chain_contracts.contract_name 中 MetaNode ERC20 代币合约的标识符
+const ContractName int32 = 2
+
+//go:embed erc20.abi.json
+var erc20ABI string
+
+//go:embed migrations/001_create_tables.sql
+var migrationCreateTablesSQL string
+
+//go:embed migrations/002_raw_units.sql
+var migrationRawUnitsSQL string
+
+//go:embed migrations/003_chain_id_keys.sql
+var migrationChainIDKeysSQL string
+
+func init() {
+	indexer.Register(module{})
+}
+
+// module ERC20 代币合约处理模块
+type module struct{}
+
+func (module) ContractName() int32 { return ContractName }
+
+func (module) Name() string { return "token" }
+
+// ABI 标准 ERC20 事件 ABI，chain_contracts.abi 为空时使用
+func (module) ABI() string { return erc20ABI }
+
+func (module) Migrations() []indexer.Migration {
+	return []indexer.Migration{
+		{Version: 1, Description: "create token_transfers and token_balances", SQL: migrationCreateTablesSQL},
+		{Version: 2, Description: "store amounts in raw token units", SQL: migrationRawUnitsSQL},
+		{Version: 3, Description: "add chain_id to token unique keys", SQL: migrationChainIDKeysSQL},
+	}
+}
+
+func (module) NewHandler(t *indexer.Task) (indexer.ContractHandler, error) {
+	return &TaskToken{Task: t}, nil
+}
+
+// TaskToken ERC20 代币合约事件处理器，t.DB 在处理区间时为同步任务的当前事务
+type TaskToken struct {
+	*indexer.Task
+}
+
+// EventHandlers 按事件名称索引的事件处理函数，Approval 只记录到 contract_events
+func (t *TaskToken) EventHandlers() map[string]indexer.EventHandler {
+	return map[string]indexer.EventHandler{
+		"Transfer": {Name: "HandleTransferEvent", Handle: t.HandleTransferEvent},
+	}
+}
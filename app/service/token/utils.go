@@ -0,0 +1,10 @@
+package token
+
+import (
+	ethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// topicToAddress 从indexed参数中解析地址
+func topicToAddress(topic ethCommon.Hash) string {
+	return ethCommon.BytesToAddress(topic.Bytes()).Hex()
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+// 订阅条件匹配的事件参数名称，与 MetaNodeStake.sol 的事件定义一致
+const (
+	argPoolID = "poolId"
+	argAmount = "amount"
+)
+
+// ParseEvents 校验并规范化逗号分隔的事件名称模式（如 "Deposit,Pause*"），返回保存到 webhook_subscriptions.events 的值
+func ParseEvents(s string) (string, error) {
+	var patterns []string
+	for _, p := range strings.Split(s, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return "", fmt.Errorf("invalid event pattern %q: %w", p, err)
+		}
+		patterns = append(patterns, p)
+	}
+	return strings.Join(patterns, ","), nil
+}
+
+// Match 判断事件是否满足订阅的过滤条件。args 为按 ABI 解码的事件参数（整数为十进制字符串），
+// 设置了资金池或最小金额条件时，不含 poolId / amount 参数的事件不匹配
+func Match(sub *model.WebhookSubscription, eventName string, args map[string]interface{}) bool {
+	if !matchEvent(sub.Events, eventName) {
+		return false
+	}
+	if sub.PoolID != nil {
+		poolID, ok := args[argPoolID].(string)
+		if !ok || poolID != strconv.Itoa(int(*sub.PoolID)) {
+			return false
+		}
+	}
+	if sub.MinAmount != nil {
+		s, ok := args[argAmount].(string)
+		if !ok {
+			return false
+		}
+		amount, err := types.ParseBigInt(s)
+		if err != nil || amount.Cmp(*sub.MinAmount) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func matchEvent(patterns, eventName string) bool {
+	if patterns == "" {
+		return true
+	}
+	for _, p := range strings.Split(patterns, ",") {
+		if ok, _ := path.Match(p, eventName); ok {
+			return true
+		}
+	}
+	return false
+}
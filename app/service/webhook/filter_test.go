@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+func TestParseEvents(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"", "", false},
+		{"Deposit", "Deposit", false},
+		{" Deposit , ,Pause* ", "Deposit,Pause*", false},
+		{"Deposit,[", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseEvents(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseEvents(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseEvents(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	pool := func(id int32) *int32 { return &id }
+	amount := func(s string) *types.BigInt {
+		b, err := types.ParseBigInt(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &b
+	}
+	deposit := map[string]interface{}{"user": "0xabc", "poolId": "1", "amount": "1000000000000000000"}
+
+	tests := []struct {
+		name  string
+		sub   model.WebhookSubscription
+		event string
+		args  map[string]interface{}
+		want  bool
+	}{
+		{"no conditions", model.WebhookSubscription{}, "Deposit", deposit, true},
+		{"no conditions nil args", model.WebhookSubscription{}, "PauseWithdraw", nil, true},
+		{"event name", model.WebhookSubscription{Events: "Deposit"}, "Deposit", deposit, true},
+		{"event name mismatch", model.WebhookSubscription{Events: "Withdraw,Claim"}, "Deposit", deposit, false},
+		{"event glob", model.WebhookSubscription{Events: "Deposit,Pause*"}, "PauseClaim", nil, true},
+		{"event glob mismatch", model.WebhookSubscription{Events: "Pause*"}, "UnpauseClaim", nil, false},
+		{"pool", model.WebhookSubscription{PoolID: pool(1)}, "Deposit", deposit, true},
+		{"pool mismatch", model.WebhookSubscription{PoolID: pool(0)}, "Deposit", deposit, false},
+		{"pool missing arg", model.WebhookSubscription{PoolID: pool(0)}, "SetMetaNodePerBlock", map[string]interface{}{"metaNodePerBlock": "1"}, false},
+		{"min amount equal", model.WebhookSubscription{MinAmount: amount("1000000000000000000")}, "Deposit", deposit, true},
+		{"min amount below", model.WebhookSubscription{MinAmount: amount("1000000000000000001")}, "Deposit", deposit, false},
+		{"min amount beyond int64", model.WebhookSubscription{MinAmount: amount("100000000000000000000")}, "Deposit",
+			map[string]interface{}{"poolId": "1", "amount": "100000000000000000001"}, true},
+		{"min amount missing arg", model.WebhookSubscription{MinAmount: amount("1")}, "Claim", map[string]interface{}{"poolId": "1"}, false},
+		{"min amount invalid arg", model.WebhookSubscription{MinAmount: amount("1")}, "Deposit", map[string]interface{}{"amount": "abc"}, false},
+		{"all conditions", model.WebhookSubscription{Events: "Deposit", PoolID: pool(1), MinAmount: amount("1")}, "Deposit", deposit, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Match(&tt.sub, tt.event, tt.args); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/outbox"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/webhooksubscriptions"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// 投递请求头
+const (
+	HeaderDelivery  = "X-Webhook-Delivery"  // 投递记录 ID，重试时不变，接收方据此去重
+	HeaderEvent     = "X-Webhook-Event"     // 事件名称
+	HeaderTimestamp = "X-Webhook-Timestamp" // 签名时间（Unix 秒）
+	HeaderSignature = "X-Webhook-Signature" // sha256=<hex(HMAC-SHA256(secret, timestamp + "." + body))>
+)
+
+// Body json 格式订阅的请求体，Event 与事件发件箱的消息内容（outbox.Payload）相同
+type Body struct {
+	ID           int64           `json:"id"`
+	Subscription string          `json:"subscription"`
+	Event        json.RawMessage `json:"event"`
+}
+
+// Sender 按订阅投递事件
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(cfg *config.WebhookConfig) *Sender {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Sender{client: &http.Client{Timeout: timeout}}
+}
+
+// Deliver 向订阅地址 POST 一条投递记录，2xx 响应视为成功。返回 HTTP 状态码，未收到响应时为 0
+func (s *Sender) Deliver(ctx context.Context, sub *model.WebhookSubscription, d *model.WebhookDelivery) (int32, error) {
+	body, err := requestBody(sub, d)
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("Deliver: create request error: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "MetaNodeStakeSync-Webhook")
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(HeaderEvent, d.EventName)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("Deliver: post error: %w", err)
+	}
+	defer resp.Body.Close()
+	// 只读取少量响应内容用于记录错误
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return int32(resp.StatusCode), fmt.Errorf("Deliver: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+	return int32(resp.StatusCode), nil
+}
+
+// Sign 计算请求签名，接收方用相同的密钥、X-Webhook-Timestamp 和原始请求体计算后比较
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func requestBody(sub *model.WebhookSubscription, d *model.WebhookDelivery) ([]byte, error) {
+	if sub.Format == webhooksubscriptions.FormatSlack {
+		var p outbox.Payload
+		if err := json.Unmarshal([]byte(d.Payload), &p); err != nil {
+			return nil, fmt.Errorf("requestBody: unmarshal payload error: %w", err)
+		}
+		return json.Marshal(map[string]string{"text": slackText(sub, &p)})
+	}
+	return json.Marshal(Body{ID: d.ID, Subscription: sub.Name, Event: json.RawMessage(d.Payload)})
+}
+
+// slackText Slack 告警文本：事件名称、合约、区块和交易，以及按名称排序的事件参数
+func slackText(sub *model.WebhookSubscription, p *outbox.Payload) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "[%s] *%s* on `%s` (chain %d)\n", sub.Name, p.Event, p.Contract, p.ChainID)
+	fmt.Fprintf(&sb, "block %d, tx `%s`", p.BlockNumber, p.TransactionHash)
+	names := make([]string, 0, len(p.Args))
+	for name := range p.Args {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(&sb, "\n• %s: %v", name, p.Args[name])
+	}
+	return sb.String()
+}
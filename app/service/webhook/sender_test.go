@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/app/service/config"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/repository/webhooksubscriptions"
+)
+
+func TestSign(t *testing.T) {
+	// 与接收方文档一致的计算方式：HMAC-SHA256(secret, timestamp + "." + body)
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(`1700000000.{"id":1}`))
+	want := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if got := Sign("s3cret", 1700000000, []byte(`{"id":1}`)); got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+	if Sign("other", 1700000000, []byte(`{"id":1}`)) == want {
+		t.Error("Sign() with a different secret should differ")
+	}
+	if Sign("s3cret", 1700000001, []byte(`{"id":1}`)) == want {
+		t.Error("Sign() with a different timestamp should differ")
+	}
+	if Sign("s3cret", 1700000000, []byte(`{"id":2}`)) == want {
+		t.Error("Sign() with a different body should differ")
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	var gotHeader http.Header
+	var gotBody []byte
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotHeader = r.Header
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte("upstream says no"))
+	}))
+	defer srv.Close()
+
+	sender := NewSender(&config.WebhookConfig{})
+	sub := &model.WebhookSubscription{ID: 2, Name: "big-deposits", URL: srv.URL, Secret: "s3cret", Format: webhooksubscriptions.FormatJSON}
+	d := &model.WebhookDelivery{ID: 15, EventName: "Deposit", Payload: `{"event":"Deposit","args":{"amount":"1"}}`}
+
+	code, err := sender.Deliver(context.Background(), sub, d)
+	if err != nil || code != http.StatusOK {
+		t.Fatalf("Deliver() = %d, %v", code, err)
+	}
+	if gotHeader.Get(HeaderDelivery) != "15" || gotHeader.Get(HeaderEvent) != "Deposit" {
+		t.Errorf("unexpected headers: %v", gotHeader)
+	}
+	ts, err := strconv.ParseInt(gotHeader.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid timestamp header: %v", err)
+	}
+	if got, want := gotHeader.Get(HeaderSignature), Sign(sub.Secret, ts, gotBody); got != want {
+		t.Errorf("signature = %s, want %s", got, want)
+	}
+	var body Body
+	if err := json.Unmarshal(gotBody, &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.ID != 15 || body.Subscription != "big-deposits" || string(body.Event) != d.Payload {
+		t.Errorf("unexpected body: %s", gotBody)
+	}
+
+	status = http.StatusInternalServerError
+	code, err = sender.Deliver(context.Background(), sub, d)
+	if err == nil || code != http.StatusInternalServerError || !strings.Contains(err.Error(), "upstream says no") {
+		t.Errorf("Deliver() = %d, %v, want 500 error with response snippet", code, err)
+	}
+}
+
+func TestSlackBody(t *testing.T) {
+	sub := &model.WebhookSubscription{Name: "ops", Format: webhooksubscriptions.FormatSlack}
+	d := &model.WebhookDelivery{Payload: `{"chain_id":11155111,"contract":"0xabc","event":"SetMetaNodePerBlock","block_number":7,"transaction_hash":"0x01","args":{"metaNodePerBlock":"20"}}`}
+	body, err := requestBody(sub, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg map[string]string
+	if err := json.Unmarshal(body, &msg); err != nil {
+		t.Fatal(err)
+	}
+	want := "[ops] *SetMetaNodePerBlock* on `0xabc` (chain 11155111)\nblock 7, tx `0x01`\n• metaNodePerBlock: 20"
+	if msg["text"] != want {
+		t.Errorf("text = %q, want %q", msg["text"], want)
+	}
+}
@@ -18,6 +18,8 @@ type ChainContract struct {
 	ContractAddress string     `gorm:"column:contract_address;type:varchar(42);not null;index:idx_contract_address,priority:1;comment:合约地址" json:"contract_address"`                 // 合约地址
 	CreatedTxHash   *string    `gorm:"column:created_tx_hash;type:varchar(66);comment:创建交易哈希" json:"created_tx_hash"`                                                                // 创建交易哈希
 	Abi             string     `gorm:"column:abi;type:text;not null;comment:合约ABI" json:"abi"`                                                                                       // 合约ABI
+	SyncMode        string     `gorm:"column:sync_mode;type:varchar(16);not null;default:latest;comment:同步高度模式 (latest / confirmations / safe / finalized)" json:"sync_mode"`        // 同步高度模式 (latest / confirmations / safe / finalized)
+	Confirmations   int32      `gorm:"column:confirmations;type:int;not null;default:0;comment:confirmations 模式下落后最新区块的确认数" json:"confirmations"`                                    // confirmations 模式下落后最新区块的确认数
 	CreatedAt       *time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdatedAt       *time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"updated_at"`
 }
@@ -10,11 +10,13 @@ import (
 
 const TableNameChainEndpoint = "chain_endpoints"
 
-// ChainEndpoint 链端点信息表
+// ChainEndpoint 链端点信息表 (同一条链可配置多个端点)
 type ChainEndpoint struct {
 	ID        int64      `gorm:"column:id;type:bigint;primaryKey;autoIncrement:true" json:"id"`
-	ChainID   int32      `gorm:"column:chain_id;type:int;not null;uniqueIndex:chain_id,priority:1;uniqueIndex:uk_chain_id,priority:1;comment:链ID" json:"chain_id"` // 链ID
-	URL       string     `gorm:"column:url;type:varchar(255);not null;comment:RPC端点URL" json:"url"`                                                                // RPC端点URL
+	ChainID   int32      `gorm:"column:chain_id;type:int;not null;uniqueIndex:uk_chain_url,priority:1;index:idx_chain_priority,priority:1;comment:链ID" json:"chain_id"` // 链ID
+	URL       string     `gorm:"column:url;type:varchar(255);not null;uniqueIndex:uk_chain_url,priority:2;comment:RPC端点URL" json:"url"`                                 // RPC端点URL
+	Priority  int32      `gorm:"column:priority;type:int;not null;index:idx_chain_priority,priority:2;comment:优先级，越小越优先" json:"priority"`                               // 优先级，越小越优先
+	RateLimit int32      `gorm:"column:rate_limit;type:int;not null;comment:每秒最多请求数，0 表示不限制" json:"rate_limit"`                                                         // 每秒最多请求数，0 表示不限制
 	CreatedAt *time.Time `gorm:"column:created_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"created_at"`
 	UpdatedAt *time.Time `gorm:"column:updated_at;type:timestamp;default:CURRENT_TIMESTAMP" json:"updated_at"`
 }
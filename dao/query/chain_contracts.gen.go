@@ -34,6 +34,8 @@ func newChainContract(db *gorm.DB, opts ...gen.DOOption) chainContract {
 	_chainContract.ContractAddress = field.NewString(tableName, "contract_address")
 	_chainContract.CreatedTxHash = field.NewString(tableName, "created_tx_hash")
 	_chainContract.Abi = field.NewString(tableName, "abi")
+	_chainContract.SyncMode = field.NewString(tableName, "sync_mode")
+	_chainContract.Confirmations = field.NewInt32(tableName, "confirmations")
 	_chainContract.CreatedAt = field.NewTime(tableName, "created_at")
 	_chainContract.UpdatedAt = field.NewTime(tableName, "updated_at")
 
@@ -53,6 +55,8 @@ type chainContract struct {
 	ContractAddress field.String // 合约地址
 	CreatedTxHash   field.String // 创建交易哈希
 	Abi             field.String // 合约ABI
+	SyncMode        field.String // 同步高度模式 (latest / confirmations / safe / finalized)
+	Confirmations   field.Int32  // confirmations 模式下落后最新区块的确认数
 	CreatedAt       field.Time
 	UpdatedAt       field.Time
 
@@ -77,6 +81,8 @@ func (c *chainContract) updateTableName(table string) *chainContract {
 	c.ContractAddress = field.NewString(table, "contract_address")
 	c.CreatedTxHash = field.NewString(table, "created_tx_hash")
 	c.Abi = field.NewString(table, "abi")
+	c.SyncMode = field.NewString(table, "sync_mode")
+	c.Confirmations = field.NewInt32(table, "confirmations")
 	c.CreatedAt = field.NewTime(table, "created_at")
 	c.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -95,13 +101,15 @@ func (c *chainContract) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (c *chainContract) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 8)
+	c.fieldMap = make(map[string]field.Expr, 10)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["chain_id"] = c.ChainID
 	c.fieldMap["contract_name"] = c.ContractName
 	c.fieldMap["contract_address"] = c.ContractAddress
 	c.fieldMap["created_tx_hash"] = c.CreatedTxHash
 	c.fieldMap["abi"] = c.Abi
+	c.fieldMap["sync_mode"] = c.SyncMode
+	c.fieldMap["confirmations"] = c.Confirmations
 	c.fieldMap["created_at"] = c.CreatedAt
 	c.fieldMap["updated_at"] = c.UpdatedAt
 }
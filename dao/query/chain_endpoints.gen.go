@@ -31,6 +31,8 @@ func newChainEndpoint(db *gorm.DB, opts ...gen.DOOption) chainEndpoint {
 	_chainEndpoint.ID = field.NewInt64(tableName, "id")
 	_chainEndpoint.ChainID = field.NewInt32(tableName, "chain_id")
 	_chainEndpoint.URL = field.NewString(tableName, "url")
+	_chainEndpoint.Priority = field.NewInt32(tableName, "priority")
+	_chainEndpoint.RateLimit = field.NewInt32(tableName, "rate_limit")
 	_chainEndpoint.CreatedAt = field.NewTime(tableName, "created_at")
 	_chainEndpoint.UpdatedAt = field.NewTime(tableName, "updated_at")
 
@@ -39,7 +41,7 @@ func newChainEndpoint(db *gorm.DB, opts ...gen.DOOption) chainEndpoint {
 	return _chainEndpoint
 }
 
-// chainEndpoint 链端点信息表
+// chainEndpoint 链端点信息表 (同一条链可配置多个端点)
 type chainEndpoint struct {
 	chainEndpointDo
 
@@ -47,6 +49,8 @@ type chainEndpoint struct {
 	ID        field.Int64
 	ChainID   field.Int32  // 链ID
 	URL       field.String // RPC端点URL
+	Priority  field.Int32  // 优先级，越小越优先
+	RateLimit field.Int32  // 每秒最多请求数，0 表示不限制
 	CreatedAt field.Time
 	UpdatedAt field.Time
 
@@ -68,6 +72,8 @@ func (c *chainEndpoint) updateTableName(table string) *chainEndpoint {
 	c.ID = field.NewInt64(table, "id")
 	c.ChainID = field.NewInt32(table, "chain_id")
 	c.URL = field.NewString(table, "url")
+	c.Priority = field.NewInt32(table, "priority")
+	c.RateLimit = field.NewInt32(table, "rate_limit")
 	c.CreatedAt = field.NewTime(table, "created_at")
 	c.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -86,10 +92,12 @@ func (c *chainEndpoint) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (c *chainEndpoint) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 5)
+	c.fieldMap = make(map[string]field.Expr, 7)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["chain_id"] = c.ChainID
 	c.fieldMap["url"] = c.URL
+	c.fieldMap["priority"] = c.Priority
+	c.fieldMap["rate_limit"] = c.RateLimit
 	c.fieldMap["created_at"] = c.CreatedAt
 	c.fieldMap["updated_at"] = c.UpdatedAt
 }
@@ -29,6 +29,7 @@ func newContractEvent(db *gorm.DB, opts ...gen.DOOption) contractEvent {
 	tableName := _contractEvent.contractEventDo.TableName()
 	_contractEvent.ALL = field.NewAsterisk(tableName)
 	_contractEvent.ID = field.NewInt64(tableName, "id")
+	_contractEvent.ChainID = field.NewInt32(tableName, "chain_id")
 	_contractEvent.ContractAddress = field.NewString(tableName, "contract_address")
 	_contractEvent.EventName = field.NewString(tableName, "event_name")
 	_contractEvent.Topic0 = field.NewString(tableName, "topic0")
@@ -53,6 +54,7 @@ type contractEvent struct {
 
 	ALL             field.Asterisk
 	ID              field.Int64
+	ChainID         field.Int32  // 链ID
 	ContractAddress field.String // Contract address
 	EventName       field.String // Event name
 	Topic0          field.String // Event signature hash
@@ -82,6 +84,7 @@ func (c contractEvent) As(alias string) *contractEvent {
 func (c *contractEvent) updateTableName(table string) *contractEvent {
 	c.ALL = field.NewAsterisk(table)
 	c.ID = field.NewInt64(table, "id")
+	c.ChainID = field.NewInt32(table, "chain_id")
 	c.ContractAddress = field.NewString(table, "contract_address")
 	c.EventName = field.NewString(table, "event_name")
 	c.Topic0 = field.NewString(table, "topic0")
@@ -110,8 +113,9 @@ func (c *contractEvent) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (c *contractEvent) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 13)
+	c.fieldMap = make(map[string]field.Expr, 14)
 	c.fieldMap["id"] = c.ID
+	c.fieldMap["chain_id"] = c.ChainID
 	c.fieldMap["contract_address"] = c.ContractAddress
 	c.fieldMap["event_name"] = c.EventName
 	c.fieldMap["topic0"] = c.Topic0
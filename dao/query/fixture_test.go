@@ -0,0 +1,84 @@
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// 生成的单元测试用零值记录多次写入同一张表，有唯一键的表从第二次写入开始冲突。
+// 测试库在写入前为唯一键中未赋值的字段填充递增的值，该文件不由 gen 生成，重新生成后依然有效。
+// 测试库是保留在磁盘上的文件，字符串值带上本次运行的时间戳，避免与上次运行留下的记录冲突
+
+var (
+	_fixtureRun = time.Now().UnixNano()
+	_fixtureSeq int64
+)
+
+func init() {
+	InitializeDB()
+	if err := _gen_test_db.Callback().Create().Before("gorm:create").Register("test:unique_keys", fillUniqueKeys); err != nil {
+		panic(fmt.Errorf("register create callback fail: %w", err))
+	}
+}
+
+// fillUniqueKeys 为待写入记录（单条或批量）的唯一键字段填充不重复的值
+func fillUniqueKeys(db *gorm.DB) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return
+	}
+	var fields []*schema.Field
+	for _, idx := range db.Statement.Schema.ParseIndexes() {
+		if idx.Class != "UNIQUE" {
+			continue
+		}
+		for _, opt := range idx.Fields {
+			fields = append(fields, opt.Field)
+		}
+	}
+	if len(fields) == 0 {
+		return
+	}
+
+	rv := reflect.Indirect(db.Statement.ReflectValue)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			fillFields(db, fields, reflect.Indirect(rv.Index(i)))
+		}
+	case reflect.Struct:
+		fillFields(db, fields, rv)
+	}
+}
+
+func fillFields(db *gorm.DB, fields []*schema.Field, rv reflect.Value) {
+	ctx := db.Statement.Context
+	for _, f := range fields {
+		if _, zero := f.ValueOf(ctx, rv); !zero {
+			continue
+		}
+		n := atomic.AddInt64(&_fixtureSeq, 1)
+		t := f.FieldType
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		var v interface{}
+		switch t.Kind() {
+		case reflect.String:
+			v = fmt.Sprintf("fixture-%d-%d", _fixtureRun, n)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			v = n
+		default:
+			continue
+		}
+		if err := f.Set(ctx, rv, v); err != nil {
+			db.AddError(fmt.Errorf("fill unique key %s fail: %w", f.Name, err))
+			return
+		}
+	}
+}
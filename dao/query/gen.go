@@ -16,11 +16,40 @@ import (
 )
 
 var (
-	Q             = new(Query)
-	ChainContract *chainContract
-	ChainEndpoint *chainEndpoint
-	ContractEvent *contractEvent
-	PoolInfo      *poolInfo
+	Q                         = new(Query)
+	ChainContract             *chainContract
+	ChainEndpoint             *chainEndpoint
+	ContractEvent             *contractEvent
+	EventClaim                *eventClaim
+	EventDeposit              *eventDeposit
+	EventOutbox               *eventOutbox
+	EventPauseClaim           *eventPauseClaim
+	EventPauseWithdraw        *eventPauseWithdraw
+	EventPaused               *eventPaused
+	EventRequestUnstake       *eventRequestUnstake
+	EventSetEndBlock          *eventSetEndBlock
+	EventSetMetanode          *eventSetMetanode
+	EventSetMetanodePerBlock  *eventSetMetanodePerBlock
+	EventSetPoolWeight        *eventSetPoolWeight
+	EventSetStartBlock        *eventSetStartBlock
+	EventUpdatePool           *eventUpdatePool
+	EventUpdatePoolInfo       *eventUpdatePoolInfo
+	EventWithdraw             *eventWithdraw
+	FailedEvent               *failedEvent
+	PoolInfo                  *poolInfo
+	ReorgSnapshot             *reorgSnapshot
+	SchemaMigration           *schemaMigration
+	StakeContractState        *stakeContractState
+	StakeContractStateHistory *stakeContractStateHistory
+	SyncBlock                 *syncBlock
+	SyncLease                 *syncLease
+	SyncStatus                *syncStatus
+	TokenBalance              *tokenBalance
+	TokenTransfer             *tokenTransfer
+	UserPoolStat              *userPoolStat
+	UserUnstakeRequest        *userUnstakeRequest
+	WebhookDelivery           *webhookDelivery
+	WebhookSubscription       *webhookSubscription
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
@@ -28,37 +57,153 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	ChainContract = &Q.ChainContract
 	ChainEndpoint = &Q.ChainEndpoint
 	ContractEvent = &Q.ContractEvent
+	EventClaim = &Q.EventClaim
+	EventDeposit = &Q.EventDeposit
+	EventOutbox = &Q.EventOutbox
+	EventPauseClaim = &Q.EventPauseClaim
+	EventPauseWithdraw = &Q.EventPauseWithdraw
+	EventPaused = &Q.EventPaused
+	EventRequestUnstake = &Q.EventRequestUnstake
+	EventSetEndBlock = &Q.EventSetEndBlock
+	EventSetMetanode = &Q.EventSetMetanode
+	EventSetMetanodePerBlock = &Q.EventSetMetanodePerBlock
+	EventSetPoolWeight = &Q.EventSetPoolWeight
+	EventSetStartBlock = &Q.EventSetStartBlock
+	EventUpdatePool = &Q.EventUpdatePool
+	EventUpdatePoolInfo = &Q.EventUpdatePoolInfo
+	EventWithdraw = &Q.EventWithdraw
+	FailedEvent = &Q.FailedEvent
 	PoolInfo = &Q.PoolInfo
+	ReorgSnapshot = &Q.ReorgSnapshot
+	SchemaMigration = &Q.SchemaMigration
+	StakeContractState = &Q.StakeContractState
+	StakeContractStateHistory = &Q.StakeContractStateHistory
+	SyncBlock = &Q.SyncBlock
+	SyncLease = &Q.SyncLease
+	SyncStatus = &Q.SyncStatus
+	TokenBalance = &Q.TokenBalance
+	TokenTransfer = &Q.TokenTransfer
+	UserPoolStat = &Q.UserPoolStat
+	UserUnstakeRequest = &Q.UserUnstakeRequest
+	WebhookDelivery = &Q.WebhookDelivery
+	WebhookSubscription = &Q.WebhookSubscription
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:            db,
-		ChainContract: newChainContract(db, opts...),
-		ChainEndpoint: newChainEndpoint(db, opts...),
-		ContractEvent: newContractEvent(db, opts...),
-		PoolInfo:      newPoolInfo(db, opts...),
+		db:                        db,
+		ChainContract:             newChainContract(db, opts...),
+		ChainEndpoint:             newChainEndpoint(db, opts...),
+		ContractEvent:             newContractEvent(db, opts...),
+		EventClaim:                newEventClaim(db, opts...),
+		EventDeposit:              newEventDeposit(db, opts...),
+		EventOutbox:               newEventOutbox(db, opts...),
+		EventPauseClaim:           newEventPauseClaim(db, opts...),
+		EventPauseWithdraw:        newEventPauseWithdraw(db, opts...),
+		EventPaused:               newEventPaused(db, opts...),
+		EventRequestUnstake:       newEventRequestUnstake(db, opts...),
+		EventSetEndBlock:          newEventSetEndBlock(db, opts...),
+		EventSetMetanode:          newEventSetMetanode(db, opts...),
+		EventSetMetanodePerBlock:  newEventSetMetanodePerBlock(db, opts...),
+		EventSetPoolWeight:        newEventSetPoolWeight(db, opts...),
+		EventSetStartBlock:        newEventSetStartBlock(db, opts...),
+		EventUpdatePool:           newEventUpdatePool(db, opts...),
+		EventUpdatePoolInfo:       newEventUpdatePoolInfo(db, opts...),
+		EventWithdraw:             newEventWithdraw(db, opts...),
+		FailedEvent:               newFailedEvent(db, opts...),
+		PoolInfo:                  newPoolInfo(db, opts...),
+		ReorgSnapshot:             newReorgSnapshot(db, opts...),
+		SchemaMigration:           newSchemaMigration(db, opts...),
+		StakeContractState:        newStakeContractState(db, opts...),
+		StakeContractStateHistory: newStakeContractStateHistory(db, opts...),
+		SyncBlock:                 newSyncBlock(db, opts...),
+		SyncLease:                 newSyncLease(db, opts...),
+		SyncStatus:                newSyncStatus(db, opts...),
+		TokenBalance:              newTokenBalance(db, opts...),
+		TokenTransfer:             newTokenTransfer(db, opts...),
+		UserPoolStat:              newUserPoolStat(db, opts...),
+		UserUnstakeRequest:        newUserUnstakeRequest(db, opts...),
+		WebhookDelivery:           newWebhookDelivery(db, opts...),
+		WebhookSubscription:       newWebhookSubscription(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	ChainContract chainContract
-	ChainEndpoint chainEndpoint
-	ContractEvent contractEvent
-	PoolInfo      poolInfo
+	ChainContract             chainContract
+	ChainEndpoint             chainEndpoint
+	ContractEvent             contractEvent
+	EventClaim                eventClaim
+	EventDeposit              eventDeposit
+	EventOutbox               eventOutbox
+	EventPauseClaim           eventPauseClaim
+	EventPauseWithdraw        eventPauseWithdraw
+	EventPaused               eventPaused
+	EventRequestUnstake       eventRequestUnstake
+	EventSetEndBlock          eventSetEndBlock
+	EventSetMetanode          eventSetMetanode
+	EventSetMetanodePerBlock  eventSetMetanodePerBlock
+	EventSetPoolWeight        eventSetPoolWeight
+	EventSetStartBlock        eventSetStartBlock
+	EventUpdatePool           eventUpdatePool
+	EventUpdatePoolInfo       eventUpdatePoolInfo
+	EventWithdraw             eventWithdraw
+	FailedEvent               failedEvent
+	PoolInfo                  poolInfo
+	ReorgSnapshot             reorgSnapshot
+	SchemaMigration           schemaMigration
+	StakeContractState        stakeContractState
+	StakeContractStateHistory stakeContractStateHistory
+	SyncBlock                 syncBlock
+	SyncLease                 syncLease
+	SyncStatus                syncStatus
+	TokenBalance              tokenBalance
+	TokenTransfer             tokenTransfer
+	UserPoolStat              userPoolStat
+	UserUnstakeRequest        userUnstakeRequest
+	WebhookDelivery           webhookDelivery
+	WebhookSubscription       webhookSubscription
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:            db,
-		ChainContract: q.ChainContract.clone(db),
-		ChainEndpoint: q.ChainEndpoint.clone(db),
-		ContractEvent: q.ContractEvent.clone(db),
-		PoolInfo:      q.PoolInfo.clone(db),
+		db:                        db,
+		ChainContract:             q.ChainContract.clone(db),
+		ChainEndpoint:             q.ChainEndpoint.clone(db),
+		ContractEvent:             q.ContractEvent.clone(db),
+		EventClaim:                q.EventClaim.clone(db),
+		EventDeposit:              q.EventDeposit.clone(db),
+		EventOutbox:               q.EventOutbox.clone(db),
+		EventPauseClaim:           q.EventPauseClaim.clone(db),
+		EventPauseWithdraw:        q.EventPauseWithdraw.clone(db),
+		EventPaused:               q.EventPaused.clone(db),
+		EventRequestUnstake:       q.EventRequestUnstake.clone(db),
+		EventSetEndBlock:          q.EventSetEndBlock.clone(db),
+		EventSetMetanode:          q.EventSetMetanode.clone(db),
+		EventSetMetanodePerBlock:  q.EventSetMetanodePerBlock.clone(db),
+		EventSetPoolWeight:        q.EventSetPoolWeight.clone(db),
+		EventSetStartBlock:        q.EventSetStartBlock.clone(db),
+		EventUpdatePool:           q.EventUpdatePool.clone(db),
+		EventUpdatePoolInfo:       q.EventUpdatePoolInfo.clone(db),
+		EventWithdraw:             q.EventWithdraw.clone(db),
+		FailedEvent:               q.FailedEvent.clone(db),
+		PoolInfo:                  q.PoolInfo.clone(db),
+		ReorgSnapshot:             q.ReorgSnapshot.clone(db),
+		SchemaMigration:           q.SchemaMigration.clone(db),
+		StakeContractState:        q.StakeContractState.clone(db),
+		StakeContractStateHistory: q.StakeContractStateHistory.clone(db),
+		SyncBlock:                 q.SyncBlock.clone(db),
+		SyncLease:                 q.SyncLease.clone(db),
+		SyncStatus:                q.SyncStatus.clone(db),
+		TokenBalance:              q.TokenBalance.clone(db),
+		TokenTransfer:             q.TokenTransfer.clone(db),
+		UserPoolStat:              q.UserPoolStat.clone(db),
+		UserUnstakeRequest:        q.UserUnstakeRequest.clone(db),
+		WebhookDelivery:           q.WebhookDelivery.clone(db),
+		WebhookSubscription:       q.WebhookSubscription.clone(db),
 	}
 }
 
@@ -72,27 +217,114 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:            db,
-		ChainContract: q.ChainContract.replaceDB(db),
-		ChainEndpoint: q.ChainEndpoint.replaceDB(db),
-		ContractEvent: q.ContractEvent.replaceDB(db),
-		PoolInfo:      q.PoolInfo.replaceDB(db),
+		db:                        db,
+		ChainContract:             q.ChainContract.replaceDB(db),
+		ChainEndpoint:             q.ChainEndpoint.replaceDB(db),
+		ContractEvent:             q.ContractEvent.replaceDB(db),
+		EventClaim:                q.EventClaim.replaceDB(db),
+		EventDeposit:              q.EventDeposit.replaceDB(db),
+		EventOutbox:               q.EventOutbox.replaceDB(db),
+		EventPauseClaim:           q.EventPauseClaim.replaceDB(db),
+		EventPauseWithdraw:        q.EventPauseWithdraw.replaceDB(db),
+		EventPaused:               q.EventPaused.replaceDB(db),
+		EventRequestUnstake:       q.EventRequestUnstake.replaceDB(db),
+		EventSetEndBlock:          q.EventSetEndBlock.replaceDB(db),
+		EventSetMetanode:          q.EventSetMetanode.replaceDB(db),
+		EventSetMetanodePerBlock:  q.EventSetMetanodePerBlock.replaceDB(db),
+		EventSetPoolWeight:        q.EventSetPoolWeight.replaceDB(db),
+		EventSetStartBlock:        q.EventSetStartBlock.replaceDB(db),
+		EventUpdatePool:           q.EventUpdatePool.replaceDB(db),
+		EventUpdatePoolInfo:       q.EventUpdatePoolInfo.replaceDB(db),
+		EventWithdraw:             q.EventWithdraw.replaceDB(db),
+		FailedEvent:               q.FailedEvent.replaceDB(db),
+		PoolInfo:                  q.PoolInfo.replaceDB(db),
+		ReorgSnapshot:             q.ReorgSnapshot.replaceDB(db),
+		SchemaMigration:           q.SchemaMigration.replaceDB(db),
+		StakeContractState:        q.StakeContractState.replaceDB(db),
+		StakeContractStateHistory: q.StakeContractStateHistory.replaceDB(db),
+		SyncBlock:                 q.SyncBlock.replaceDB(db),
+		SyncLease:                 q.SyncLease.replaceDB(db),
+		SyncStatus:                q.SyncStatus.replaceDB(db),
+		TokenBalance:              q.TokenBalance.replaceDB(db),
+		TokenTransfer:             q.TokenTransfer.replaceDB(db),
+		UserPoolStat:              q.UserPoolStat.replaceDB(db),
+		UserUnstakeRequest:        q.UserUnstakeRequest.replaceDB(db),
+		WebhookDelivery:           q.WebhookDelivery.replaceDB(db),
+		WebhookSubscription:       q.WebhookSubscription.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	ChainContract IChainContractDo
-	ChainEndpoint IChainEndpointDo
-	ContractEvent IContractEventDo
-	PoolInfo      IPoolInfoDo
+	ChainContract             IChainContractDo
+	ChainEndpoint             IChainEndpointDo
+	ContractEvent             IContractEventDo
+	EventClaim                IEventClaimDo
+	EventDeposit              IEventDepositDo
+	EventOutbox               IEventOutboxDo
+	EventPauseClaim           IEventPauseClaimDo
+	EventPauseWithdraw        IEventPauseWithdrawDo
+	EventPaused               IEventPausedDo
+	EventRequestUnstake       IEventRequestUnstakeDo
+	EventSetEndBlock          IEventSetEndBlockDo
+	EventSetMetanode          IEventSetMetanodeDo
+	EventSetMetanodePerBlock  IEventSetMetanodePerBlockDo
+	EventSetPoolWeight        IEventSetPoolWeightDo
+	EventSetStartBlock        IEventSetStartBlockDo
+	EventUpdatePool           IEventUpdatePoolDo
+	EventUpdatePoolInfo       IEventUpdatePoolInfoDo
+	EventWithdraw             IEventWithdrawDo
+	FailedEvent               IFailedEventDo
+	PoolInfo                  IPoolInfoDo
+	ReorgSnapshot             IReorgSnapshotDo
+	SchemaMigration           ISchemaMigrationDo
+	StakeContractState        IStakeContractStateDo
+	StakeContractStateHistory IStakeContractStateHistoryDo
+	SyncBlock                 ISyncBlockDo
+	SyncLease                 ISyncLeaseDo
+	SyncStatus                ISyncStatusDo
+	TokenBalance              ITokenBalanceDo
+	TokenTransfer             ITokenTransferDo
+	UserPoolStat              IUserPoolStatDo
+	UserUnstakeRequest        IUserUnstakeRequestDo
+	WebhookDelivery           IWebhookDeliveryDo
+	WebhookSubscription       IWebhookSubscriptionDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		ChainContract: q.ChainContract.WithContext(ctx),
-		ChainEndpoint: q.ChainEndpoint.WithContext(ctx),
-		ContractEvent: q.ContractEvent.WithContext(ctx),
-		PoolInfo:      q.PoolInfo.WithContext(ctx),
+		ChainContract:             q.ChainContract.WithContext(ctx),
+		ChainEndpoint:             q.ChainEndpoint.WithContext(ctx),
+		ContractEvent:             q.ContractEvent.WithContext(ctx),
+		EventClaim:                q.EventClaim.WithContext(ctx),
+		EventDeposit:              q.EventDeposit.WithContext(ctx),
+		EventOutbox:               q.EventOutbox.WithContext(ctx),
+		EventPauseClaim:           q.EventPauseClaim.WithContext(ctx),
+		EventPauseWithdraw:        q.EventPauseWithdraw.WithContext(ctx),
+		EventPaused:               q.EventPaused.WithContext(ctx),
+		EventRequestUnstake:       q.EventRequestUnstake.WithContext(ctx),
+		EventSetEndBlock:          q.EventSetEndBlock.WithContext(ctx),
+		EventSetMetanode:          q.EventSetMetanode.WithContext(ctx),
+		EventSetMetanodePerBlock:  q.EventSetMetanodePerBlock.WithContext(ctx),
+		EventSetPoolWeight:        q.EventSetPoolWeight.WithContext(ctx),
+		EventSetStartBlock:        q.EventSetStartBlock.WithContext(ctx),
+		EventUpdatePool:           q.EventUpdatePool.WithContext(ctx),
+		EventUpdatePoolInfo:       q.EventUpdatePoolInfo.WithContext(ctx),
+		EventWithdraw:             q.EventWithdraw.WithContext(ctx),
+		FailedEvent:               q.FailedEvent.WithContext(ctx),
+		PoolInfo:                  q.PoolInfo.WithContext(ctx),
+		ReorgSnapshot:             q.ReorgSnapshot.WithContext(ctx),
+		SchemaMigration:           q.SchemaMigration.WithContext(ctx),
+		StakeContractState:        q.StakeContractState.WithContext(ctx),
+		StakeContractStateHistory: q.StakeContractStateHistory.WithContext(ctx),
+		SyncBlock:                 q.SyncBlock.WithContext(ctx),
+		SyncLease:                 q.SyncLease.WithContext(ctx),
+		SyncStatus:                q.SyncStatus.WithContext(ctx),
+		TokenBalance:              q.TokenBalance.WithContext(ctx),
+		TokenTransfer:             q.TokenTransfer.WithContext(ctx),
+		UserPoolStat:              q.UserPoolStat.WithContext(ctx),
+		UserUnstakeRequest:        q.UserUnstakeRequest.WithContext(ctx),
+		WebhookDelivery:           q.WebhookDelivery.WithContext(ctx),
+		WebhookSubscription:       q.WebhookSubscription.WithContext(ctx),
 	}
 }
 
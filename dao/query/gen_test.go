@@ -80,7 +80,36 @@ func Test_WithContext(t *testing.T) {
 		qCtx.ChainContract.UnderlyingDB().Statement.Context,
 		qCtx.ChainEndpoint.UnderlyingDB().Statement.Context,
 		qCtx.ContractEvent.UnderlyingDB().Statement.Context,
+		qCtx.EventClaim.UnderlyingDB().Statement.Context,
+		qCtx.EventDeposit.UnderlyingDB().Statement.Context,
+		qCtx.EventOutbox.UnderlyingDB().Statement.Context,
+		qCtx.EventPauseClaim.UnderlyingDB().Statement.Context,
+		qCtx.EventPauseWithdraw.UnderlyingDB().Statement.Context,
+		qCtx.EventPaused.UnderlyingDB().Statement.Context,
+		qCtx.EventRequestUnstake.UnderlyingDB().Statement.Context,
+		qCtx.EventSetEndBlock.UnderlyingDB().Statement.Context,
+		qCtx.EventSetMetanode.UnderlyingDB().Statement.Context,
+		qCtx.EventSetMetanodePerBlock.UnderlyingDB().Statement.Context,
+		qCtx.EventSetPoolWeight.UnderlyingDB().Statement.Context,
+		qCtx.EventSetStartBlock.UnderlyingDB().Statement.Context,
+		qCtx.EventUpdatePool.UnderlyingDB().Statement.Context,
+		qCtx.EventUpdatePoolInfo.UnderlyingDB().Statement.Context,
+		qCtx.EventWithdraw.UnderlyingDB().Statement.Context,
+		qCtx.FailedEvent.UnderlyingDB().Statement.Context,
 		qCtx.PoolInfo.UnderlyingDB().Statement.Context,
+		qCtx.ReorgSnapshot.UnderlyingDB().Statement.Context,
+		qCtx.SchemaMigration.UnderlyingDB().Statement.Context,
+		qCtx.StakeContractState.UnderlyingDB().Statement.Context,
+		qCtx.StakeContractStateHistory.UnderlyingDB().Statement.Context,
+		qCtx.SyncBlock.UnderlyingDB().Statement.Context,
+		qCtx.SyncLease.UnderlyingDB().Statement.Context,
+		qCtx.SyncStatus.UnderlyingDB().Statement.Context,
+		qCtx.TokenBalance.UnderlyingDB().Statement.Context,
+		qCtx.TokenTransfer.UnderlyingDB().Statement.Context,
+		qCtx.UserPoolStat.UnderlyingDB().Statement.Context,
+		qCtx.UserUnstakeRequest.UnderlyingDB().Statement.Context,
+		qCtx.WebhookDelivery.UnderlyingDB().Statement.Context,
+		qCtx.WebhookSubscription.UnderlyingDB().Statement.Context,
 	} {
 		if v := ctx.Value(key); v != value {
 			t.Errorf("get value from context fail, expect %q, got %q", value, v)
@@ -29,16 +29,17 @@ func newPoolInfo(db *gorm.DB, opts ...gen.DOOption) poolInfo {
 	tableName := _poolInfo.poolInfoDo.TableName()
 	_poolInfo.ALL = field.NewAsterisk(tableName)
 	_poolInfo.ID = field.NewInt32(tableName, "id")
+	_poolInfo.ChainID = field.NewInt32(tableName, "chain_id")
 	_poolInfo.PoolID = field.NewInt32(tableName, "pool_id")
 	_poolInfo.ContractAddress = field.NewString(tableName, "contract_address")
 	_poolInfo.StTokenAddress = field.NewString(tableName, "st_token_address")
-	_poolInfo.PoolWeight = field.NewFloat64(tableName, "pool_weight")
+	_poolInfo.PoolWeight = field.NewField(tableName, "pool_weight")
 	_poolInfo.LastRewardBlock = field.NewUint64(tableName, "last_reward_block")
-	_poolInfo.AccMetanodePerSt = field.NewFloat64(tableName, "acc_metanode_per_st")
-	_poolInfo.StTokenAmount = field.NewFloat64(tableName, "st_token_amount")
-	_poolInfo.MinDepositAmount = field.NewFloat64(tableName, "min_deposit_amount")
+	_poolInfo.AccMetanodePerSt = field.NewField(tableName, "acc_metanode_per_st")
+	_poolInfo.StTokenAmount = field.NewField(tableName, "st_token_amount")
+	_poolInfo.MinDepositAmount = field.NewField(tableName, "min_deposit_amount")
 	_poolInfo.UnstakeLockedBlocks = field.NewInt32(tableName, "unstake_locked_blocks")
-	_poolInfo.TotalPoolWeight = field.NewFloat64(tableName, "total_pool_weight")
+	_poolInfo.TotalPoolWeight = field.NewField(tableName, "total_pool_weight")
 	_poolInfo.IsActive = field.NewBool(tableName, "is_active")
 	_poolInfo.CreatedBlock = field.NewUint64(tableName, "created_block")
 	_poolInfo.CreatedTx = field.NewString(tableName, "created_tx")
@@ -56,19 +57,20 @@ type poolInfo struct {
 
 	ALL                 field.Asterisk
 	ID                  field.Int32
-	PoolID              field.Int32   // 资金池ID
-	ContractAddress     field.String  // 合约地址
-	StTokenAddress      field.String  // 质押代币地址 (0x0 表示ETH)
-	PoolWeight          field.Float64 // 资金池权重
-	LastRewardBlock     field.Uint64  // 最后奖励区块
-	AccMetanodePerSt    field.Float64 // 每质押代币累计MetaNode
-	StTokenAmount       field.Float64 // 质押代币总量
-	MinDepositAmount    field.Float64 // 最小质押金额
-	UnstakeLockedBlocks field.Int32   // 解锁区块数
-	TotalPoolWeight     field.Float64 // 所有池的总权重
-	IsActive            field.Bool    // 是否激活
-	CreatedBlock        field.Uint64  // 创建时的区块号
-	CreatedTx           field.String  // 创建交易哈希
+	ChainID             field.Int32  // 链ID
+	PoolID              field.Int32  // 资金池ID
+	ContractAddress     field.String // 合约地址
+	StTokenAddress      field.String // 质押代币地址 (0x0 表示ETH)
+	PoolWeight          field.Field  // 资金池权重
+	LastRewardBlock     field.Uint64 // 最后奖励区块
+	AccMetanodePerSt    field.Field  // 每质押代币累计MetaNode
+	StTokenAmount       field.Field  // 质押代币总量
+	MinDepositAmount    field.Field  // 最小质押金额
+	UnstakeLockedBlocks field.Int32  // 解锁区块数
+	TotalPoolWeight     field.Field  // 所有池的总权重
+	IsActive            field.Bool   // 是否激活
+	CreatedBlock        field.Uint64 // 创建时的区块号
+	CreatedTx           field.String // 创建交易哈希
 	CreatedAt           field.Time
 	UpdatedAt           field.Time
 
@@ -88,16 +90,17 @@ func (p poolInfo) As(alias string) *poolInfo {
 func (p *poolInfo) updateTableName(table string) *poolInfo {
 	p.ALL = field.NewAsterisk(table)
 	p.ID = field.NewInt32(table, "id")
+	p.ChainID = field.NewInt32(table, "chain_id")
 	p.PoolID = field.NewInt32(table, "pool_id")
 	p.ContractAddress = field.NewString(table, "contract_address")
 	p.StTokenAddress = field.NewString(table, "st_token_address")
-	p.PoolWeight = field.NewFloat64(table, "pool_weight")
+	p.PoolWeight = field.NewField(table, "pool_weight")
 	p.LastRewardBlock = field.NewUint64(table, "last_reward_block")
-	p.AccMetanodePerSt = field.NewFloat64(table, "acc_metanode_per_st")
-	p.StTokenAmount = field.NewFloat64(table, "st_token_amount")
-	p.MinDepositAmount = field.NewFloat64(table, "min_deposit_amount")
+	p.AccMetanodePerSt = field.NewField(table, "acc_metanode_per_st")
+	p.StTokenAmount = field.NewField(table, "st_token_amount")
+	p.MinDepositAmount = field.NewField(table, "min_deposit_amount")
 	p.UnstakeLockedBlocks = field.NewInt32(table, "unstake_locked_blocks")
-	p.TotalPoolWeight = field.NewFloat64(table, "total_pool_weight")
+	p.TotalPoolWeight = field.NewField(table, "total_pool_weight")
 	p.IsActive = field.NewBool(table, "is_active")
 	p.CreatedBlock = field.NewUint64(table, "created_block")
 	p.CreatedTx = field.NewString(table, "created_tx")
@@ -119,8 +122,9 @@ func (p *poolInfo) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (p *poolInfo) fillFieldMap() {
-	p.fieldMap = make(map[string]field.Expr, 16)
+	p.fieldMap = make(map[string]field.Expr, 17)
 	p.fieldMap["id"] = p.ID
+	p.fieldMap["chain_id"] = p.ChainID
 	p.fieldMap["pool_id"] = p.PoolID
 	p.fieldMap["contract_address"] = p.ContractAddress
 	p.fieldMap["st_token_address"] = p.StTokenAddress
@@ -1,10 +1,12 @@
 package chaincontract
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
 	"github.com/dijiacoder/MetaNodeStakeSync/dao/query"
+	"gorm.io/gorm"
 )
 
 type ChainContract model.ChainContract
@@ -12,8 +14,8 @@ type ChainContract model.ChainContract
 type ChainEndpoint model.ChainEndpoint
 
 type ContractWithEndpoint struct {
-	ChainContract ChainContract
-	ChainEndpoint ChainEndpoint
+	ChainContract  ChainContract
+	ChainEndpoints []ChainEndpoint // 合约所在链的全部端点，按优先级升序
 }
 
 func GetContractWithEndPoint() ([]ContractWithEndpoint, error) {
@@ -24,26 +26,44 @@ func GetContractWithEndPoint() ([]ContractWithEndpoint, error) {
 	}
 
 	// 获取所有端点信息
-	endpoints, err := query.ChainEndpoint.Find()
+	endpoints, err := query.ChainEndpoint.Order(query.ChainEndpoint.Priority, query.ChainEndpoint.ID).Find()
 	if err != nil {
 		return nil, fmt.Errorf("failed to query chain endpoints: %v", err)
 	}
 
-	// 创建端点映射
-	endpointMap := make(map[int32]ChainEndpoint)
+	// 按链分组端点
+	endpointMap := make(map[int32][]ChainEndpoint)
 	for _, endpoint := range endpoints {
-		endpointMap[endpoint.ChainID] = ChainEndpoint(*endpoint)
+		endpointMap[endpoint.ChainID] = append(endpointMap[endpoint.ChainID], ChainEndpoint(*endpoint))
 	}
 
 	// 构建结果
 	var result []ContractWithEndpoint
 	for _, contract := range contracts {
 		contractWithEndpoint := ContractWithEndpoint{
-			ChainContract: ChainContract(*contract),
-			ChainEndpoint: endpointMap[contract.ChainID],
+			ChainContract:  ChainContract(*contract),
+			ChainEndpoints: endpointMap[contract.ChainID],
 		}
 		result = append(result, contractWithEndpoint)
 	}
 
 	return result, nil
 }
+
+// List 返回所有已注册的合约，按链ID和合约地址排序
+func List(ctx context.Context, db *gorm.DB) ([]*model.ChainContract, error) {
+	var res []*model.ChainContract
+	if err := db.WithContext(ctx).Order("chain_id ASC, contract_address ASC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListByAddress 返回指定地址的合约，同一地址可能注册在多条链上
+func ListByAddress(ctx context.Context, db *gorm.DB, contractAddress string) ([]*model.ChainContract, error) {
+	var res []*model.ChainContract
+	if err := db.WithContext(ctx).Where("contract_address = ?", contractAddress).Order("chain_id ASC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
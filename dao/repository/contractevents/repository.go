@@ -11,14 +11,141 @@ func Create(ctx context.Context, db *gorm.DB, item *model.ContractEvent) error {
 	return db.WithContext(ctx).Create(item).Error
 }
 
-func ExistsByTxHash(ctx context.Context, db *gorm.DB, txHash string) (bool, error) {
+func ExistsByTxHash(ctx context.Context, db *gorm.DB, chainID int32, txHash string) (bool, error) {
 	var count int64
 	err := db.WithContext(ctx).
 		Model(&model.ContractEvent{}).
-		Where("transaction_hash = ?", txHash).
+		Where("chain_id = ? AND transaction_hash = ?", chainID, txHash).
 		Count(&count).Error
 	if err != nil {
 		return false, err
 	}
 	return count > 0, nil
 }
+
+// ExistsByTxHashAndLogIndex 按(交易哈希, 日志序号)判断事件是否已入库，同一交易可能包含多条日志
+func ExistsByTxHashAndLogIndex(ctx context.Context, db *gorm.DB, chainID int32, txHash string, logIndex int32) (bool, error) {
+	var count int64
+	err := db.WithContext(ctx).
+		Model(&model.ContractEvent{}).
+		Where("chain_id = ? AND transaction_hash = ? AND log_index = ?", chainID, txHash, logIndex).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// DeleteAfterBlock 删除合约在 blockNumber 之后的事件（链重组回滚）
+func DeleteAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Delete(&model.ContractEvent{}).Error
+}
+
+// ListFilter 事件查询条件，零值字段不参与过滤
+type ListFilter struct {
+	ChainID         int32
+	ContractAddress string
+	EventNames      []string
+	FromBlock       uint64
+	ToBlock         uint64       // 0 表示不限
+	TopicMatches    []TopicMatch // 按索引参数过滤，多个条件之间为 OR
+	Desc            bool         // 按 (block_number, log_index) 倒序
+}
+
+// TopicMatch 限定事件名称及其索引参数（contract_events.topic1/topic2 中的32字节十六进制值）
+type TopicMatch struct {
+	EventNames []string
+	Topic1     string
+	Topic2     string
+}
+
+// List 分页查询合约事件，返回当前页和符合条件的总数
+func List(ctx context.Context, db *gorm.DB, filter ListFilter, offset, limit int) ([]*model.ContractEvent, int64, error) {
+	tx := listQuery(ctx, db, filter)
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "block_number ASC, log_index ASC"
+	if filter.Desc {
+		order = "block_number DESC, log_index DESC"
+	}
+	var res []*model.ContractEvent
+	if err := tx.Order(order).Offset(offset).Limit(limit).Find(&res).Error; err != nil {
+		return nil, 0, err
+	}
+	return res, total, nil
+}
+
+// ListAfter 按 (block_number, log_index) 升序返回游标之后的事件，filter.Desc 被忽略。
+// logIndex 为 -1 时包含 blockNumber 区块的全部事件
+func ListAfter(ctx context.Context, db *gorm.DB, filter ListFilter, blockNumber uint64, logIndex int32, limit int) ([]*model.ContractEvent, error) {
+	var res []*model.ContractEvent
+	err := listQuery(ctx, db, filter).
+		Where("block_number > ? OR (block_number = ? AND log_index > ?)", blockNumber, blockNumber, logIndex).
+		Order("block_number ASC, log_index ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// GetLast 返回合约最新的一条事件
+func GetLast(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) (*model.ContractEvent, error) {
+	var res model.ContractEvent
+	err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).
+		Order("block_number DESC, log_index DESC").
+		First(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func listQuery(ctx context.Context, db *gorm.DB, filter ListFilter) *gorm.DB {
+	tx := db.WithContext(ctx).Model(&model.ContractEvent{}).Where("chain_id = ? AND contract_address = ?", filter.ChainID, filter.ContractAddress)
+	if len(filter.EventNames) > 0 {
+		tx = tx.Where("event_name IN ?", filter.EventNames)
+	}
+	if filter.FromBlock > 0 {
+		tx = tx.Where("block_number >= ?", filter.FromBlock)
+	}
+	if filter.ToBlock > 0 {
+		tx = tx.Where("block_number <= ?", filter.ToBlock)
+	}
+	if len(filter.TopicMatches) > 0 {
+		cond := db.Session(&gorm.Session{NewDB: true})
+		for i, m := range filter.TopicMatches {
+			mc := db.Session(&gorm.Session{NewDB: true}).Where("event_name IN ?", m.EventNames)
+			if m.Topic1 != "" {
+				mc = mc.Where("topic1 = ?", m.Topic1)
+			}
+			if m.Topic2 != "" {
+				mc = mc.Where("topic2 = ?", m.Topic2)
+			}
+			if i == 0 {
+				cond = cond.Where(mc)
+			} else {
+				cond = cond.Or(mc)
+			}
+		}
+		tx = tx.Where(cond)
+	}
+	return tx
+}
+
+// ListAfterBlock 按 (block_number, log_index) 倒序列出合约在 blockNumber 之后的事件
+func ListAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) ([]*model.ContractEvent, error) {
+	var res []*model.ContractEvent
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Order("block_number DESC, log_index DESC").
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package contractstate
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func Create(ctx context.Context, db *gorm.DB, item *model.StakeContractState) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func GetByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) (*model.StakeContractState, error) {
+	var res model.StakeContractState
+	if err := db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func UpdateByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, updates map[string]interface{}) error {
+	return db.WithContext(ctx).Model(&model.StakeContractState{}).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Updates(updates).Error
+}
+
+func ListByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) ([]*model.StakeContractState, error) {
+	var res []*model.StakeContractState
+	if err := db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReplaceByContract 用给定行整体替换合约全局参数（链重组回滚时恢复快照）
+func ReplaceByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, items []*model.StakeContractState) error {
+	if err := db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.StakeContractState{}).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(items).Error
+}
+
+// SaveHistory 记录合约在 blockNumber 区块变更后的完整参数，同一区块多次变更时保留最后一次
+func SaveHistory(ctx context.Context, db *gorm.DB, blockNumber uint64, state *model.StakeContractState) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "chain_id"}, {Name: "contract_address"}, {Name: "block_number"}},
+		DoUpdates: clause.AssignmentColumns([]string{"metanode_token", "start_block", "end_block", "metanode_per_block", "withdraw_paused", "claim_paused", "paused"}),
+	}).Create(&model.StakeContractStateHistory{
+		ChainID:          state.ChainID,
+		ContractAddress:  state.ContractAddress,
+		BlockNumber:      blockNumber,
+		MetanodeToken:    state.MetanodeToken,
+		StartBlock:       state.StartBlock,
+		EndBlock:         state.EndBlock,
+		MetanodePerBlock: state.MetanodePerBlock,
+		WithdrawPaused:   state.WithdrawPaused,
+		ClaimPaused:      state.ClaimPaused,
+		Paused:           state.Paused,
+	}).Error
+}
+
+// GetHistoryAt 返回合约在 blockNumber 区块（含该区块内的变更）生效的参数，没有记录时返回 gorm.ErrRecordNotFound
+func GetHistoryAt(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) (*model.StakeContractStateHistory, error) {
+	var res model.StakeContractStateHistory
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number <= ?", chainID, contractAddress, blockNumber).
+		Order("block_number DESC").
+		First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteHistoryAfterBlock 删除合约在 blockNumber 之后的参数历史（链重组回滚）
+func DeleteHistoryAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Delete(&model.StakeContractStateHistory{}).Error
+}
+
+// DeleteByContract 删除合约的参数和全部参数历史（全量重建）
+func DeleteByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) error {
+	if err := db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.StakeContractState{}).Error; err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.StakeContractStateHistory{}).Error
+}
@@ -0,0 +1,120 @@
+package contractstate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+)
+
+const (
+	testChainID  = 1
+	testContract = "0x1111111111111111111111111111111111111111"
+)
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 金额列使用 TEXT，避免 sqlite 把大整数转为浮点数
+	if err := db.Exec(`CREATE TABLE stake_contract_state_history (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		chain_id INT NOT NULL,
+		contract_address VARCHAR(42) NOT NULL,
+		block_number INT NOT NULL,
+		metanode_token VARCHAR(42),
+		start_block INT,
+		end_block INT,
+		metanode_per_block TEXT,
+		withdraw_paused BOOLEAN NOT NULL DEFAULT FALSE,
+		claim_paused BOOLEAN NOT NULL DEFAULT FALSE,
+		paused BOOLEAN NOT NULL DEFAULT FALSE,
+		created_at TIMESTAMP,
+		updated_at TIMESTAMP,
+		UNIQUE (chain_id, contract_address, block_number)
+	)`).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newState(chainID int32, startBlock uint64, perBlock int64, paused bool) *model.StakeContractState {
+	end := uint64(1000)
+	v := types.NewBigIntFromInt64(perBlock)
+	return &model.StakeContractState{ChainID: chainID, ContractAddress: testContract, StartBlock: &startBlock, EndBlock: &end, MetanodePerBlock: &v, Paused: paused}
+}
+
+func TestHistory(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	for _, h := range []struct {
+		block uint64
+		state *model.StakeContractState
+	}{
+		{100, newState(testChainID, 100, 10, false)},
+		{150, newState(testChainID, 100, 20, false)},
+		// 同一区块多次变更时保留最后一次
+		{150, newState(testChainID, 100, 30, false)},
+		{200, newState(testChainID, 100, 30, true)},
+		// 其他链上同一地址的合约互不影响
+		{120, newState(2, 100, 99, false)},
+		{250, newState(2, 100, 99, false)},
+	} {
+		if err := SaveHistory(ctx, db, h.block, h.state); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		block        uint64
+		wantBlock    uint64
+		wantPerBlock string
+		wantPaused   bool
+		wantNotFound bool
+	}{
+		{block: 99, wantNotFound: true},
+		{block: 100, wantBlock: 100, wantPerBlock: "10"},
+		{block: 149, wantBlock: 100, wantPerBlock: "10"},
+		{block: 150, wantBlock: 150, wantPerBlock: "30"},
+		{block: 300, wantBlock: 200, wantPerBlock: "30", wantPaused: true},
+	}
+	for _, tt := range tests {
+		h, err := GetHistoryAt(ctx, db, testChainID, testContract, tt.block)
+		if tt.wantNotFound {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				t.Fatalf("GetHistoryAt(%d) err = %v, want ErrRecordNotFound", tt.block, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("GetHistoryAt(%d) error: %v", tt.block, err)
+		}
+		if h.BlockNumber != tt.wantBlock || h.MetanodePerBlock.String() != tt.wantPerBlock || h.Paused != tt.wantPaused {
+			t.Fatalf("GetHistoryAt(%d) = block %d perBlock %s paused %v, want block %d perBlock %s paused %v",
+				tt.block, h.BlockNumber, h.MetanodePerBlock, h.Paused, tt.wantBlock, tt.wantPerBlock, tt.wantPaused)
+		}
+	}
+
+	// 链重组回滚后公共祖先之后的历史不再生效
+	if err := DeleteHistoryAfterBlock(ctx, db, testChainID, testContract, 150); err != nil {
+		t.Fatal(err)
+	}
+	h, err := GetHistoryAt(ctx, db, testChainID, testContract, 300)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.BlockNumber != 150 || h.Paused {
+		t.Fatalf("GetHistoryAt(300) after rollback = block %d paused %v, want block 150 paused false", h.BlockNumber, h.Paused)
+	}
+	if h, err := GetHistoryAt(ctx, db, 2, testContract, 300); err != nil || h.BlockNumber != 250 {
+		t.Fatalf("GetHistoryAt on chain 2 after rollback = %+v, %v, want block 250", h, err)
+	}
+}
@@ -0,0 +1,65 @@
+package eventoutbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+)
+
+// 消息类型
+const (
+	KindEvent   = "event"   // 新提交的事件
+	KindRetract = "retract" // 链重组撤回已提交的事件
+)
+
+// CreateBatch 写入发件箱消息，需与对应的 contract_events 变更在同一事务中调用
+func CreateBatch(ctx context.Context, db *gorm.DB, items []*model.EventOutbox) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(items).Error
+}
+
+// ListUnpublished 按写入顺序列出合约尚未发布的消息
+func ListUnpublished(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, limit int) ([]*model.EventOutbox, error) {
+	var res []*model.EventOutbox
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND published_at IS NULL", chainID, contractAddress).
+		Order("id ASC").
+		Limit(limit).
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func MarkPublished(ctx context.Context, db *gorm.DB, ids []int64, publishedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).
+		Model(&model.EventOutbox{}).
+		Where("id IN ?", ids).
+		Update("published_at", publishedAt).Error
+}
+
+// MarkFailed 记录发布失败，消息保持未发布状态等待下一轮重试
+func MarkFailed(ctx context.Context, db *gorm.DB, id int64, errMsg string) error {
+	return db.WithContext(ctx).
+		Model(&model.EventOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": errMsg,
+		}).Error
+}
+
+// DeletePublishedBefore 清理合约在 before 之前已发布的消息
+func DeletePublishedBefore(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, before time.Time) (int64, error) {
+	res := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND published_at IS NOT NULL AND published_at < ?", chainID, contractAddress, before).
+		Delete(&model.EventOutbox{})
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,103 @@
+package failedevents
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 死信状态
+const (
+	StatusPending  = "pending"  // 同步任务已暂停在该事件，等待运维处理
+	StatusRetrying = "retrying" // 运维要求重试，同步任务恢复并重新处理该事件所在区间
+	StatusResolved = "resolved" // 重试成功
+	StatusDropped  = "dropped"  // 运维手动丢弃，同步任务跳过该事件的 handler
+)
+
+// Create 写入失败事件，同一日志再次失败时更新错误信息、累加尝试次数并重新暂停同步
+func Create(ctx context.Context, db *gorm.DB, item *model.FailedEvent) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "chain_id"}, {Name: "transaction_hash"}, {Name: "log_index"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"error_message": item.ErrorMessage,
+			"attempts":      gorm.Expr("attempts + ?", item.Attempts),
+			"status":        StatusPending,
+		}),
+	}).Create(item).Error
+}
+
+func GetByID(ctx context.Context, db *gorm.DB, id int64) (*model.FailedEvent, error) {
+	var res model.FailedEvent
+	if err := db.WithContext(ctx).Where("id = ?", id).First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// List 按状态列出失败事件，status 为空时列出全部
+func List(ctx context.Context, db *gorm.DB, status string, limit int) ([]*model.FailedEvent, error) {
+	var res []*model.FailedEvent
+	q := db.WithContext(ctx).Order("id ASC").Limit(limit)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	if err := q.Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetFirstPending 返回合约链上顺序最早的待处理失败事件，存在时同步任务暂停
+func GetFirstPending(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) (*model.FailedEvent, error) {
+	var res model.FailedEvent
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND status = ?", chainID, contractAddress, StatusPending).
+		Order("block_number ASC, log_index ASC").
+		First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// IsDropped 判断日志对应的失败事件是否已被运维丢弃
+func IsDropped(ctx context.Context, db *gorm.DB, chainID int32, txHash string, logIndex int32) (bool, error) {
+	var count int64
+	if err := db.WithContext(ctx).Model(&model.FailedEvent{}).
+		Where("chain_id = ? AND transaction_hash = ? AND log_index = ? AND status = ?", chainID, txHash, logIndex, StatusDropped).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// MarkRetrying 把待处理的失败事件标记为重试，同步任务下一轮恢复并按链上顺序重新处理
+func MarkRetrying(ctx context.Context, db *gorm.DB, ids []int64) (int64, error) {
+	res := db.WithContext(ctx).Model(&model.FailedEvent{}).
+		Where("id IN ? AND status = ?", ids, StatusPending).
+		Update("status", StatusRetrying)
+	return res.RowsAffected, res.Error
+}
+
+// ResolveThrough 把合约 blockNumber 及之前的重试中事件标记为已解决，与推进同步进度在同一事务中执行
+func ResolveThrough(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).Model(&model.FailedEvent{}).
+		Where("chain_id = ? AND contract_address = ? AND status = ? AND block_number <= ?", chainID, contractAddress, StatusRetrying, blockNumber).
+		Update("status", StatusResolved).Error
+}
+
+// MarkDropped 丢弃失败事件，同步任务恢复并跳过该事件的 handler
+func MarkDropped(ctx context.Context, db *gorm.DB, ids []int64) (int64, error) {
+	res := db.WithContext(ctx).Model(&model.FailedEvent{}).
+		Where("id IN ? AND status IN ?", ids, []string{StatusPending, StatusRetrying}).
+		Update("status", StatusDropped)
+	return res.RowsAffected, res.Error
+}
+
+// DeleteAfterBlock 删除合约在 blockNumber 之后的失败事件（链重组回滚）
+func DeleteAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Delete(&model.FailedEvent{}).Error
+}
@@ -0,0 +1,147 @@
+package failedevents
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+)
+
+const testContract = "0x1111111111111111111111111111111111111111"
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec(`CREATE TABLE failed_events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		chain_id INT NOT NULL,
+		contract_address VARCHAR(42) NOT NULL,
+		event_name VARCHAR(100) NOT NULL,
+		handler_name VARCHAR(100) NOT NULL,
+		block_number BIGINT NOT NULL,
+		transaction_hash VARCHAR(66) NOT NULL,
+		log_index INT NOT NULL,
+		raw_log TEXT NOT NULL,
+		error_message TEXT NOT NULL,
+		attempts INT NOT NULL DEFAULT 1,
+		status VARCHAR(16) NOT NULL DEFAULT 'pending',
+		created_at TIMESTAMP,
+		updated_at TIMESTAMP,
+		UNIQUE (chain_id, transaction_hash, log_index)
+	)`).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func newFailedEvent(block uint64, txHash string, logIndex int32) *model.FailedEvent {
+	return &model.FailedEvent{
+		ChainID:         1,
+		ContractAddress: testContract,
+		EventName:       "Deposit",
+		HandlerName:     "HandleDepositEvent",
+		BlockNumber:     block,
+		TransactionHash: txHash,
+		LogIndex:        logIndex,
+		RawLog:          "{}",
+		ErrorMessage:    "boom",
+		Attempts:        5,
+		Status:          StatusPending,
+	}
+}
+
+func TestLifecycle(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+
+	if _, err := GetFirstPending(ctx, db, 1, testContract); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("GetFirstPending on empty table = %v, want ErrRecordNotFound", err)
+	}
+
+	if err := Create(ctx, db, newFailedEvent(20, "0xb", 0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := Create(ctx, db, newFailedEvent(10, "0xa", 3)); err != nil {
+		t.Fatal(err)
+	}
+
+	// 按链上顺序返回最早的待处理事件
+	first, err := GetFirstPending(ctx, db, 1, testContract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.TransactionHash != "0xa" {
+		t.Fatalf("GetFirstPending = %s, want 0xa", first.TransactionHash)
+	}
+	if _, err := GetFirstPending(ctx, db, 2, testContract); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("GetFirstPending on other chain = %v, want ErrRecordNotFound", err)
+	}
+
+	// 重试后不再暂停同步，区间提交后标记为已解决
+	if n, err := MarkRetrying(ctx, db, []int64{first.ID}); err != nil || n != 1 {
+		t.Fatalf("MarkRetrying = %d, %v, want 1, nil", n, err)
+	}
+	if err := ResolveThrough(ctx, db, 1, testContract, 9); err != nil {
+		t.Fatal(err)
+	}
+	if fe, _ := GetByID(ctx, db, first.ID); fe.Status != StatusRetrying {
+		t.Fatalf("status before checkpoint = %s, want %s", fe.Status, StatusRetrying)
+	}
+	if err := ResolveThrough(ctx, db, 1, testContract, 10); err != nil {
+		t.Fatal(err)
+	}
+	if fe, _ := GetByID(ctx, db, first.ID); fe.Status != StatusResolved {
+		t.Fatalf("status after checkpoint = %s, want %s", fe.Status, StatusResolved)
+	}
+
+	// 再次失败时重新暂停同步并累加尝试次数
+	second, err := GetFirstPending(ctx, db, 1, testContract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := MarkRetrying(ctx, db, []int64{second.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := Create(ctx, db, newFailedEvent(20, "0xb", 0)); err != nil {
+		t.Fatal(err)
+	}
+	fe, err := GetByID(ctx, db, second.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fe.Status != StatusPending || fe.Attempts != 10 {
+		t.Fatalf("after second failure = %s/%d, want %s/10", fe.Status, fe.Attempts, StatusPending)
+	}
+
+	// 丢弃后同步恢复，handler 跳过该日志
+	if n, err := MarkDropped(ctx, db, []int64{second.ID}); err != nil || n != 1 {
+		t.Fatalf("MarkDropped = %d, %v, want 1, nil", n, err)
+	}
+	if _, err := GetFirstPending(ctx, db, 1, testContract); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("GetFirstPending after drop = %v, want ErrRecordNotFound", err)
+	}
+	for _, tt := range []struct {
+		txHash   string
+		logIndex int32
+		want     bool
+	}{
+		{"0xb", 0, true},
+		{"0xb", 1, false},
+		{"0xa", 3, false},
+	} {
+		got, err := IsDropped(ctx, db, 1, tt.txHash, tt.logIndex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("IsDropped(%s, %d) = %v, want %v", tt.txHash, tt.logIndex, got, tt.want)
+		}
+	}
+}
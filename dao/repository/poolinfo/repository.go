@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
 	"gorm.io/gorm"
 )
 
@@ -11,30 +12,48 @@ func Create(ctx context.Context, db *gorm.DB, item *model.PoolInfo) error {
 	return db.WithContext(ctx).Create(item).Error
 }
 
-func GetByPoolIDAndContract(ctx context.Context, db *gorm.DB, poolID int32, contractAddress string) (*model.PoolInfo, error) {
+func GetByPoolIDAndContract(ctx context.Context, db *gorm.DB, chainID int32, poolID int32, contractAddress string) (*model.PoolInfo, error) {
 	var res model.PoolInfo
-	if err := db.WithContext(ctx).Where("pool_id = ? AND contract_address = ?", poolID, contractAddress).First(&res).Error; err != nil {
+	if err := db.WithContext(ctx).Where("chain_id = ? AND pool_id = ? AND contract_address = ?", chainID, poolID, contractAddress).First(&res).Error; err != nil {
 		return nil, err
 	}
 	return &res, nil
 }
 
-func UpdateByPoolIDAndContract(ctx context.Context, db *gorm.DB, poolID int32, contractAddress string, updates map[string]interface{}) error {
-	return db.WithContext(ctx).Model(&model.PoolInfo{}).Where("pool_id = ? AND contract_address = ?", poolID, contractAddress).Updates(updates).Error
+func UpdateByPoolIDAndContract(ctx context.Context, db *gorm.DB, chainID int32, poolID int32, contractAddress string, updates map[string]interface{}) error {
+	return db.WithContext(ctx).Model(&model.PoolInfo{}).Where("chain_id = ? AND pool_id = ? AND contract_address = ?", chainID, poolID, contractAddress).Updates(updates).Error
 }
 
-func ExistsByPoolIDAndContract(ctx context.Context, db *gorm.DB, poolID int32, contractAddress string) (bool, error) {
+// UpdateByContract 更新同一合约下的所有资金池，用于 total_pool_weight 等全局字段
+func UpdateByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, updates map[string]interface{}) error {
+	return db.WithContext(ctx).Model(&model.PoolInfo{}).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Updates(updates).Error
+}
+
+// SumPoolWeightByContract 计算同一合约下所有资金池的权重之和
+func SumPoolWeightByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) (types.BigInt, error) {
+	var total types.BigInt
+	if err := db.WithContext(ctx).
+		Model(&model.PoolInfo{}).
+		Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).
+		Select("COALESCE(SUM(pool_weight), 0)").
+		Scan(&total).Error; err != nil {
+		return types.BigInt{}, err
+	}
+	return total, nil
+}
+
+func ExistsByPoolIDAndContract(ctx context.Context, db *gorm.DB, chainID int32, poolID int32, contractAddress string) (bool, error) {
 	var count int64
-	err := db.WithContext(ctx).Model(&model.PoolInfo{}).Where("pool_id = ? AND contract_address = ?", poolID, contractAddress).Count(&count).Error
+	err := db.WithContext(ctx).Model(&model.PoolInfo{}).Where("chain_id = ? AND pool_id = ? AND contract_address = ?", chainID, poolID, contractAddress).Count(&count).Error
 	if err != nil {
 		return false, err
 	}
 	return count > 0, nil
 }
 
-func ExistsByCreatedTx(ctx context.Context, db *gorm.DB, createdTx string) (bool, error) {
+func ExistsByCreatedTx(ctx context.Context, db *gorm.DB, chainID int32, createdTx string) (bool, error) {
 	var count int64
-	err := db.WithContext(ctx).Model(&model.PoolInfo{}).Where("created_tx = ?", createdTx).Count(&count).Error
+	err := db.WithContext(ctx).Model(&model.PoolInfo{}).Where("chain_id = ? AND created_tx = ?", chainID, createdTx).Count(&count).Error
 	if err != nil {
 		return false, err
 	}
@@ -42,13 +61,37 @@ func ExistsByCreatedTx(ctx context.Context, db *gorm.DB, createdTx string) (bool
 }
 
 // GetNextPoolID 根据表中的数据量计算NextPoolID，如果当前是0条，则NextPoolID=0。
-func GetNextPoolID(ctx context.Context, db *gorm.DB, contractAddress string) (int32, error) {
+func GetNextPoolID(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) (int32, error) {
 	var count int64
 	if err := db.WithContext(ctx).
 		Model(&model.PoolInfo{}).
-		Where("contract_address = ?", contractAddress).
+		Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).
 		Count(&count).Error; err != nil {
 		return 0, err
 	}
 	return int32(count), nil
 }
+
+func ListByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) ([]*model.PoolInfo, error) {
+	var res []*model.PoolInfo
+	if err := db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Order("pool_id ASC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReplaceByContract 用给定行整体替换合约下的资金池（链重组回滚时恢复快照）
+func ReplaceByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, items []*model.PoolInfo) error {
+	if err := db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.PoolInfo{}).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(items).Error
+}
+
+// DeleteByContract 删除合约的全部资金池（全量重建）
+func DeleteByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) error {
+	return db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.PoolInfo{}).Error
+}
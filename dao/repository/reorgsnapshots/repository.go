@@ -0,0 +1,38 @@
+package reorgsnapshots
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateIfNotExists 写入快照，同一(区块, 表, 范围)已存在时保留最早的快照
+func CreateIfNotExists(ctx context.Context, db *gorm.DB, item *model.ReorgSnapshot) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(item).Error
+}
+
+// ListAfterBlock 按区块号正序列出大于 blockNumber 的快照
+func ListAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) ([]*model.ReorgSnapshot, error) {
+	var res []*model.ReorgSnapshot
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Order("block_number ASC, id ASC").
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func DeleteAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Delete(&model.ReorgSnapshot{}).Error
+}
+
+func DeleteBeforeBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number < ?", chainID, contractAddress, blockNumber).
+		Delete(&model.ReorgSnapshot{}).Error
+}
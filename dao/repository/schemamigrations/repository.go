@@ -0,0 +1,27 @@
+package schemamigrations
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+)
+
+// ListVersionsByModule 返回模块已执行的迁移版本
+func ListVersionsByModule(ctx context.Context, db *gorm.DB, module string) (map[int32]bool, error) {
+	var versions []int32
+	if err := db.WithContext(ctx).Model(&model.SchemaMigration{}).
+		Where("module = ?", module).
+		Pluck("version", &versions).Error; err != nil {
+		return nil, err
+	}
+	res := make(map[int32]bool, len(versions))
+	for _, v := range versions {
+		res[v] = true
+	}
+	return res, nil
+}
+
+func Create(ctx context.Context, db *gorm.DB, item *model.SchemaMigration) error {
+	return db.WithContext(ctx).Create(item).Error
+}
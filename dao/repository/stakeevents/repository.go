@@ -0,0 +1,94 @@
+package stakeevents
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+)
+
+func CreateDeposit(ctx context.Context, db *gorm.DB, item *model.EventDeposit) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateRequestUnstake(ctx context.Context, db *gorm.DB, item *model.EventRequestUnstake) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateWithdraw(ctx context.Context, db *gorm.DB, item *model.EventWithdraw) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateClaim(ctx context.Context, db *gorm.DB, item *model.EventClaim) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateUpdatePoolInfo(ctx context.Context, db *gorm.DB, item *model.EventUpdatePoolInfo) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateSetPoolWeight(ctx context.Context, db *gorm.DB, item *model.EventSetPoolWeight) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateUpdatePool(ctx context.Context, db *gorm.DB, item *model.EventUpdatePool) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateSetMetanode(ctx context.Context, db *gorm.DB, item *model.EventSetMetanode) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateSetStartBlock(ctx context.Context, db *gorm.DB, item *model.EventSetStartBlock) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateSetEndBlock(ctx context.Context, db *gorm.DB, item *model.EventSetEndBlock) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreateSetMetanodePerBlock(ctx context.Context, db *gorm.DB, item *model.EventSetMetanodePerBlock) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreatePauseWithdraw(ctx context.Context, db *gorm.DB, item *model.EventPauseWithdraw) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreatePauseClaim(ctx context.Context, db *gorm.DB, item *model.EventPauseClaim) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func CreatePaused(ctx context.Context, db *gorm.DB, item *model.EventPaused) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+// eventModels 所有 stake 事件表，链重组回滚时按区块号统一删除
+var eventModels = []interface{}{
+	&model.EventDeposit{},
+	&model.EventRequestUnstake{},
+	&model.EventWithdraw{},
+	&model.EventClaim{},
+	&model.EventUpdatePoolInfo{},
+	&model.EventSetPoolWeight{},
+	&model.EventUpdatePool{},
+	&model.EventSetMetanode{},
+	&model.EventSetStartBlock{},
+	&model.EventSetEndBlock{},
+	&model.EventSetMetanodePerBlock{},
+	&model.EventPauseWithdraw{},
+	&model.EventPauseClaim{},
+	&model.EventPaused{},
+}
+
+// DeleteAfterBlock 删除合约在 blockNumber 之后的所有 stake 事件（链重组回滚）
+func DeleteAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	for _, m := range eventModels {
+		if err := db.WithContext(ctx).
+			Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+			Delete(m).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
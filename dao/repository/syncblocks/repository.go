@@ -0,0 +1,71 @@
+package syncblocks
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateBatch 批量写入已同步区块哈希，同一区块重复写入时覆盖为最新哈希
+func CreateBatch(ctx context.Context, db *gorm.DB, items []*model.SyncBlock) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "chain_id"}, {Name: "contract_address"}, {Name: "block_number"}},
+		DoUpdates: clause.AssignmentColumns([]string{"block_hash", "parent_hash"}),
+	}).Create(items).Error
+}
+
+func GetByBlockNumber(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) (*model.SyncBlock, error) {
+	var res model.SyncBlock
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number = ?", chainID, contractAddress, blockNumber).
+		First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ListByBlockNumbers 返回指定区块的已同步区块哈希，已超出重组窗口被清理的区块不返回
+func ListByBlockNumbers(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumbers []uint64) ([]*model.SyncBlock, error) {
+	var res []*model.SyncBlock
+	if len(blockNumbers) == 0 {
+		return res, nil
+	}
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number IN ?", chainID, contractAddress, blockNumbers).
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListBeforeBlockDesc 按区块号倒序列出不大于 blockNumber 的已同步区块，用于回溯公共祖先
+func ListBeforeBlockDesc(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64, limit int) ([]*model.SyncBlock, error) {
+	var res []*model.SyncBlock
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number <= ?", chainID, contractAddress, blockNumber).
+		Order("block_number DESC").
+		Limit(limit).
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DeleteAfterBlock 删除大于 blockNumber 的区块哈希（链重组回滚）
+func DeleteAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Delete(&model.SyncBlock{}).Error
+}
+
+// DeleteBeforeBlock 删除小于 blockNumber 的区块哈希（超出重组窗口后清理）
+func DeleteBeforeBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number < ?", chainID, contractAddress, blockNumber).
+		Delete(&model.SyncBlock{}).Error
+}
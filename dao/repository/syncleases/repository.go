@@ -0,0 +1,64 @@
+package syncleases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrLeaseLost 租约已过期或被其他进程取得
+var ErrLeaseLost = errors.New("sync lease lost")
+
+// Acquire 获取或续期合约的同步租约：租约不存在、已过期或已由 owner 持有时改为由 owner 持有到 expiresAt。
+// 返回操作后的租约记录，Owner 不是 owner 时表示租约由其他进程持有
+func Acquire(ctx context.Context, db *gorm.DB, chainID int32, contractAddress, owner string, now, expiresAt time.Time) (*model.SyncLease, error) {
+	if err := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&model.SyncLease{
+		ChainID:         chainID,
+		ContractAddress: contractAddress,
+		Owner:           owner,
+		ExpiresAt:       expiresAt,
+	}).Error; err != nil {
+		return nil, err
+	}
+	if err := db.WithContext(ctx).Model(&model.SyncLease{}).
+		Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).
+		Where("owner = ? OR expires_at < ?", owner, now).
+		Updates(map[string]interface{}{"owner": owner, "expires_at": expiresAt}).Error; err != nil {
+		return nil, err
+	}
+	var res model.SyncLease
+	if err := db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Check 在写入派生表的事务中锁定并校验租约仍由 owner 持有且未过期，否则返回 ErrLeaseLost。
+// 行锁使其他进程在本事务提交前无法取得租约
+func Check(ctx context.Context, tx *gorm.DB, chainID int32, contractAddress, owner string, now time.Time) error {
+	var res model.SyncLease
+	err := tx.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).
+		First(&res).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrLeaseLost
+	}
+	if err != nil {
+		return err
+	}
+	if res.Owner != owner || res.ExpiresAt.Before(now) {
+		return ErrLeaseLost
+	}
+	return nil
+}
+
+// Release 释放 owner 持有的租约
+func Release(ctx context.Context, db *gorm.DB, chainID int32, contractAddress, owner string) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND owner = ?", chainID, contractAddress, owner).
+		Delete(&model.SyncLease{}).Error
+}
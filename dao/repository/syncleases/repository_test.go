@@ -0,0 +1,120 @@
+package syncleases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const testContract = "0x1111111111111111111111111111111111111111"
+
+func newTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec(`CREATE TABLE sync_leases (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		chain_id INT NOT NULL,
+		contract_address VARCHAR(42) NOT NULL,
+		owner VARCHAR(128) NOT NULL,
+		expires_at TIMESTAMP NOT NULL,
+		created_at TIMESTAMP,
+		updated_at TIMESTAMP,
+		UNIQUE (chain_id, contract_address)
+	)`).Error; err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestAcquire(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	now := time.Now()
+
+	lease, err := Acquire(ctx, db, 1, testContract, "daemon", now, now.Add(30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Owner != "daemon" {
+		t.Fatalf("owner = %s, want daemon", lease.Owner)
+	}
+
+	// 未过期时其他进程无法取得
+	lease, err = Acquire(ctx, db, 1, testContract, "backfill", now.Add(time.Second), now.Add(31*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Owner != "daemon" {
+		t.Fatalf("owner = %s, want daemon", lease.Owner)
+	}
+
+	// 持有者可以续期
+	lease, err = Acquire(ctx, db, 1, testContract, "daemon", now.Add(10*time.Second), now.Add(40*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Owner != "daemon" || !lease.ExpiresAt.Equal(now.Add(40*time.Second)) {
+		t.Fatalf("lease = %s until %s, want daemon until %s", lease.Owner, lease.ExpiresAt, now.Add(40*time.Second))
+	}
+
+	// 过期后其他进程可以取得
+	lease, err = Acquire(ctx, db, 1, testContract, "backfill", now.Add(41*time.Second), now.Add(71*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Owner != "backfill" {
+		t.Fatalf("owner = %s, want backfill", lease.Owner)
+	}
+
+	// 其他合约的租约互不影响
+	lease, err = Acquire(ctx, db, 2, testContract, "daemon", now, now.Add(30*time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Owner != "daemon" {
+		t.Fatalf("owner on chain 2 = %s, want daemon", lease.Owner)
+	}
+}
+
+func TestCheckAndRelease(t *testing.T) {
+	ctx := context.Background()
+	db := newTestDB(t)
+	now := time.Now()
+
+	if err := Check(ctx, db, 1, testContract, "daemon", now); !errors.Is(err, ErrLeaseLost) {
+		t.Fatalf("Check without lease = %v, want ErrLeaseLost", err)
+	}
+	if _, err := Acquire(ctx, db, 1, testContract, "daemon", now, now.Add(30*time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if err := Check(ctx, db, 1, testContract, "daemon", now.Add(time.Second)); err != nil {
+		t.Fatalf("Check by owner = %v, want nil", err)
+	}
+	if err := Check(ctx, db, 1, testContract, "backfill", now.Add(time.Second)); !errors.Is(err, ErrLeaseLost) {
+		t.Fatalf("Check by other = %v, want ErrLeaseLost", err)
+	}
+	if err := Check(ctx, db, 1, testContract, "daemon", now.Add(31*time.Second)); !errors.Is(err, ErrLeaseLost) {
+		t.Fatalf("Check after expiry = %v, want ErrLeaseLost", err)
+	}
+
+	// 只有持有者可以释放
+	if err := Release(ctx, db, 1, testContract, "backfill"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Check(ctx, db, 1, testContract, "daemon", now.Add(time.Second)); err != nil {
+		t.Fatalf("Check after release by other = %v, want nil", err)
+	}
+	if err := Release(ctx, db, 1, testContract, "daemon"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Check(ctx, db, 1, testContract, "daemon", now.Add(time.Second)); !errors.Is(err, ErrLeaseLost) {
+		t.Fatalf("Check after release = %v, want ErrLeaseLost", err)
+	}
+}
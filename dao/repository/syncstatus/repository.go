@@ -0,0 +1,49 @@
+package syncstatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func GetByContractAndChain(ctx context.Context, db *gorm.DB, contractAddress string, chainID int32) (*model.SyncStatus, error) {
+	var res model.SyncStatus
+	if err := db.WithContext(ctx).Where("contract_address = ? AND chain_id = ?", contractAddress, chainID).First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// SaveCheckpoint 写入同步进度并清空同步错误，需要与事件写入在同一事务中调用
+func SaveCheckpoint(ctx context.Context, db *gorm.DB, contractAddress string, chainID int32, lastSyncedBlock uint64, isSyncing bool) error {
+	now := time.Now()
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "contract_address"}, {Name: "chain_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_synced_block": lastSyncedBlock,
+			"last_sync_time":    now,
+			"sync_error":        nil,
+			"is_syncing":        isSyncing,
+		}),
+	}).Create(&model.SyncStatus{
+		ContractAddress: contractAddress,
+		ChainID:         chainID,
+		LastSyncedBlock: lastSyncedBlock,
+		LastSyncTime:    &now,
+		IsSyncing:       &isSyncing,
+	}).Error
+}
+
+// UpdateSyncError 记录最近一次同步失败的错误信息，不改变同步进度
+func UpdateSyncError(ctx context.Context, db *gorm.DB, contractAddress string, chainID int32, syncError string) error {
+	return db.WithContext(ctx).
+		Model(&model.SyncStatus{}).
+		Where("contract_address = ? AND chain_id = ?", contractAddress, chainID).
+		Updates(map[string]interface{}{
+			"sync_error":     syncError,
+			"last_sync_time": time.Now(),
+		}).Error
+}
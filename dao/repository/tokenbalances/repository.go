@@ -0,0 +1,51 @@
+package tokenbalances
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AddBalance 变更持有人余额，记录不存在时以 delta 为初始余额创建
+func AddBalance(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, holderAddress string, delta types.BigInt, blockNumber uint64) error {
+	return db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "chain_id"}, {Name: "contract_address"}, {Name: "holder_address"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"balance":            types.IncrExpr("balance", delta),
+			"last_updated_block": blockNumber,
+		}),
+	}).Create(&model.TokenBalance{
+		ChainID:          chainID,
+		ContractAddress:  contractAddress,
+		HolderAddress:    holderAddress,
+		Balance:          delta,
+		LastUpdatedBlock: blockNumber,
+	}).Error
+}
+
+func ListByHolderAndContract(ctx context.Context, db *gorm.DB, chainID int32, holderAddress string, contractAddress string) ([]*model.TokenBalance, error) {
+	var res []*model.TokenBalance
+	if err := db.WithContext(ctx).Where("chain_id = ? AND holder_address = ? AND contract_address = ?", chainID, holderAddress, contractAddress).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReplaceByHolderAndContract 用给定行整体替换持有人在合约下的余额（链重组回滚时恢复快照）
+func ReplaceByHolderAndContract(ctx context.Context, db *gorm.DB, chainID int32, holderAddress string, contractAddress string, items []*model.TokenBalance) error {
+	if err := db.WithContext(ctx).Where("chain_id = ? AND holder_address = ? AND contract_address = ?", chainID, holderAddress, contractAddress).Delete(&model.TokenBalance{}).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(items).Error
+}
+
+// DeleteByContract 删除合约下全部持有人余额（全量重建）
+func DeleteByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) error {
+	return db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.TokenBalance{}).Error
+}
@@ -0,0 +1,19 @@
+package tokentransfers
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+)
+
+func Create(ctx context.Context, db *gorm.DB, item *model.TokenTransfer) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+// DeleteAfterBlock 删除合约在 blockNumber 之后的转账记录（链重组回滚）
+func DeleteAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ?", chainID, contractAddress, blockNumber).
+		Delete(&model.TokenTransfer{}).Error
+}
@@ -0,0 +1,87 @@
+package unstakerequests
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+)
+
+func Create(ctx context.Context, db *gorm.DB, item *model.UserUnstakeRequest) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+// ListPendingByUserPoolAndContract 按申请顺序(FIFO)返回用户在资金池中尚未提现的解质押请求
+func ListPendingByUserPoolAndContract(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, poolID int32, contractAddress string) ([]*model.UserUnstakeRequest, error) {
+	var res []*model.UserUnstakeRequest
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND user_address = ? AND pool_id = ? AND contract_address = ? AND is_withdrawn = ?", chainID, userAddress, poolID, contractAddress, false).
+		Order("request_block ASC, id ASC").
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func MarkWithdrawnByIDs(ctx context.Context, db *gorm.DB, ids []int64, withdrawnBlock uint64, withdrawnTx string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).
+		Model(&model.UserUnstakeRequest{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"is_withdrawn":    true,
+			"withdrawn_block": withdrawnBlock,
+			"withdrawn_tx":    withdrawnTx,
+		}).Error
+}
+
+func ListByUserAndContract(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, contractAddress string) ([]*model.UserUnstakeRequest, error) {
+	var res []*model.UserUnstakeRequest
+	if err := db.WithContext(ctx).Where("chain_id = ? AND user_address = ? AND contract_address = ?", chainID, userAddress, contractAddress).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReplaceByUserAndContract 用给定行整体替换用户在合约下的解质押请求（链重组回滚时恢复快照）
+func ReplaceByUserAndContract(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, contractAddress string, items []*model.UserUnstakeRequest) error {
+	if err := db.WithContext(ctx).Where("chain_id = ? AND user_address = ? AND contract_address = ?", chainID, userAddress, contractAddress).Delete(&model.UserUnstakeRequest{}).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(items).Error
+}
+
+// DeleteByContract 删除合约下全部解质押请求（全量重建）
+func DeleteByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) error {
+	return db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.UserUnstakeRequest{}).Error
+}
+
+// ListFilter 解质押请求查询条件，PoolID 为 nil 时不限资金池，IsWithdrawn 为 nil 时不限提现状态
+type ListFilter struct {
+	ChainID         int32
+	UserAddress     string
+	ContractAddress string
+	PoolID          *int32
+	IsWithdrawn     *bool
+}
+
+// List 按申请顺序返回符合条件的解质押请求
+func List(ctx context.Context, db *gorm.DB, filter ListFilter) ([]*model.UserUnstakeRequest, error) {
+	tx := db.WithContext(ctx).Where("chain_id = ? AND user_address = ? AND contract_address = ?", filter.ChainID, filter.UserAddress, filter.ContractAddress)
+	if filter.PoolID != nil {
+		tx = tx.Where("pool_id = ?", *filter.PoolID)
+	}
+	if filter.IsWithdrawn != nil {
+		tx = tx.Where("is_withdrawn = ?", *filter.IsWithdrawn)
+	}
+	var res []*model.UserUnstakeRequest
+	if err := tx.Order("request_block ASC, id ASC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,74 @@
+package userpoolstats
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+)
+
+func GetByUserPoolAndContract(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, poolID int32, contractAddress string) (*model.UserPoolStat, error) {
+	var res model.UserPoolStat
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND user_address = ? AND pool_id = ? AND contract_address = ?", chainID, userAddress, poolID, contractAddress).
+		First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// FirstOrCreate 查询用户在资金池中的统计记录，不存在时创建一条全零记录
+func FirstOrCreate(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, poolID int32, contractAddress string) (*model.UserPoolStat, error) {
+	res := model.UserPoolStat{
+		ChainID:         chainID,
+		UserAddress:     userAddress,
+		PoolID:          poolID,
+		ContractAddress: contractAddress,
+	}
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND user_address = ? AND pool_id = ? AND contract_address = ?", chainID, userAddress, poolID, contractAddress).
+		FirstOrCreate(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func UpdateByUserPoolAndContract(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, poolID int32, contractAddress string, updates map[string]interface{}) error {
+	return db.WithContext(ctx).
+		Model(&model.UserPoolStat{}).
+		Where("chain_id = ? AND user_address = ? AND pool_id = ? AND contract_address = ?", chainID, userAddress, poolID, contractAddress).
+		Updates(updates).Error
+}
+
+func ListByUserAndContract(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, contractAddress string) ([]*model.UserPoolStat, error) {
+	var res []*model.UserPoolStat
+	if err := db.WithContext(ctx).Where("chain_id = ? AND user_address = ? AND contract_address = ?", chainID, userAddress, contractAddress).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListByPoolsAndContract 列出合约下指定资金池的全部用户统计
+func ListByPoolsAndContract(ctx context.Context, db *gorm.DB, chainID int32, poolIDs []int32, contractAddress string) ([]*model.UserPoolStat, error) {
+	var res []*model.UserPoolStat
+	if err := db.WithContext(ctx).Where("chain_id = ? AND pool_id IN ? AND contract_address = ?", chainID, poolIDs, contractAddress).Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReplaceByUserAndContract 用给定行整体替换用户在合约下的统计（链重组回滚时恢复快照）
+func ReplaceByUserAndContract(ctx context.Context, db *gorm.DB, chainID int32, userAddress string, contractAddress string, items []*model.UserPoolStat) error {
+	if err := db.WithContext(ctx).Where("chain_id = ? AND user_address = ? AND contract_address = ?", chainID, userAddress, contractAddress).Delete(&model.UserPoolStat{}).Error; err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Create(items).Error
+}
+
+// DeleteByContract 删除合约下全部用户统计（全量重建）
+func DeleteByContract(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) error {
+	return db.WithContext(ctx).Where("chain_id = ? AND contract_address = ?", chainID, contractAddress).Delete(&model.UserPoolStat{}).Error
+}
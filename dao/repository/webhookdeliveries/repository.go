@@ -0,0 +1,118 @@
+package webhookdeliveries
+
+import (
+	"context"
+	"time"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 投递状态
+const (
+	StatusPending   = "pending"   // 等待投递或重试
+	StatusDelivered = "delivered" // 投递成功
+	StatusFailed    = "failed"    // 超过最大投递次数，需运维通过 webhook redeliver 重新投递
+)
+
+// CreateBatch 写入待投递记录，需与对应的 contract_events 在同一事务中调用；同一订阅的同一日志只投递一次
+func CreateBatch(ctx context.Context, db *gorm.DB, items []*model.WebhookDelivery) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(items).Error
+}
+
+func GetByID(ctx context.Context, db *gorm.DB, id int64) (*model.WebhookDelivery, error) {
+	var res model.WebhookDelivery
+	if err := db.WithContext(ctx).Where("id = ?", id).First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// List 按投递记录 ID 倒序列出，subscriptionID 为 0 时不限订阅，status 为空时不限状态
+func List(ctx context.Context, db *gorm.DB, subscriptionID int64, status string, limit int) ([]*model.WebhookDelivery, error) {
+	var res []*model.WebhookDelivery
+	q := db.WithContext(ctx).Order("id DESC").Limit(limit)
+	if subscriptionID != 0 {
+		q = q.Where("subscription_id = ?", subscriptionID)
+	}
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	if err := q.Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListDue 列出合约到达投递时间的待投递记录。已停用订阅的记录保持待投递，重新启用后继续投递；
+// 有记录正在退避等待重试的订阅整体跳过，避免接收方故障期间继续投递后续事件
+func ListDue(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	var res []*model.WebhookDelivery
+	enabled := db.Model(&model.WebhookSubscription{}).Select("id").Where("enabled = ?", true)
+	backingOff := db.Model(&model.WebhookDelivery{}).Distinct("subscription_id").
+		Where("chain_id = ? AND contract_address = ? AND status = ? AND next_retry_at > ?", chainID, contractAddress, StatusPending, now)
+	if err := db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND status = ? AND (next_retry_at IS NULL OR next_retry_at <= ?)", chainID, contractAddress, StatusPending, now).
+		Where("subscription_id IN (?)", enabled).
+		Where("subscription_id NOT IN (?)", backingOff).
+		Order("id ASC").
+		Limit(limit).
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func MarkDelivered(ctx context.Context, db *gorm.DB, id int64, responseStatus int32, deliveredAt time.Time) error {
+	return db.WithContext(ctx).Model(&model.WebhookDelivery{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":          StatusDelivered,
+		"attempts":        gorm.Expr("attempts + 1"),
+		"response_status": responseStatus,
+		"last_error":      nil,
+		"next_retry_at":   nil,
+		"delivered_at":    deliveredAt,
+	}).Error
+}
+
+// MarkAttemptFailed 记录一次失败的投递，nextRetryAt 为 nil 时不再自动重试。responseStatus 为 0 表示没有收到响应
+func MarkAttemptFailed(ctx context.Context, db *gorm.DB, id int64, responseStatus int32, errMsg string, nextRetryAt *time.Time) error {
+	updates := map[string]interface{}{
+		"attempts":      gorm.Expr("attempts + 1"),
+		"last_error":    errMsg,
+		"next_retry_at": nextRetryAt,
+	}
+	if responseStatus != 0 {
+		updates["response_status"] = responseStatus
+	}
+	if nextRetryAt == nil {
+		updates["status"] = StatusFailed
+	}
+	return db.WithContext(ctx).Model(&model.WebhookDelivery{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Redeliver 把投递记录重置为立即投递，投递次数从 0 重新计算
+func Redeliver(ctx context.Context, db *gorm.DB, ids []int64) (int64, error) {
+	res := db.WithContext(ctx).Model(&model.WebhookDelivery{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+		"status":        StatusPending,
+		"attempts":      0,
+		"next_retry_at": nil,
+		"delivered_at":  nil,
+	})
+	return res.RowsAffected, res.Error
+}
+
+// DeletePendingAfterBlock 删除合约在 blockNumber 之后尚未投递成功的记录（链重组回滚），已投递的记录保留作为投递日志
+func DeletePendingAfterBlock(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string, blockNumber uint64) error {
+	return db.WithContext(ctx).
+		Where("chain_id = ? AND contract_address = ? AND block_number > ? AND status <> ?", chainID, contractAddress, blockNumber, StatusDelivered).
+		Delete(&model.WebhookDelivery{}).Error
+}
+
+// DeleteBySubscription 删除订阅的全部投递记录
+func DeleteBySubscription(ctx context.Context, db *gorm.DB, subscriptionID int64) error {
+	return db.WithContext(ctx).Where("subscription_id = ?", subscriptionID).Delete(&model.WebhookDelivery{}).Error
+}
@@ -0,0 +1,56 @@
+package webhooksubscriptions
+
+import (
+	"context"
+
+	"github.com/dijiacoder/MetaNodeStakeSync/dao/model"
+	"gorm.io/gorm"
+)
+
+// 消息格式
+const (
+	FormatJSON  = "json"  // 带签名的事件 JSON
+	FormatSlack = "slack" // Slack incoming webhook 兼容的 {"text": ...} 消息
+)
+
+func Create(ctx context.Context, db *gorm.DB, item *model.WebhookSubscription) error {
+	return db.WithContext(ctx).Create(item).Error
+}
+
+func GetByID(ctx context.Context, db *gorm.DB, id int64) (*model.WebhookSubscription, error) {
+	var res model.WebhookSubscription
+	if err := db.WithContext(ctx).Where("id = ?", id).First(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func List(ctx context.Context, db *gorm.DB) ([]*model.WebhookSubscription, error) {
+	var res []*model.WebhookSubscription
+	if err := db.WithContext(ctx).Order("id ASC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListEnabled 列出可能匹配指定合约事件的已启用订阅，事件名称和参数条件由调用方匹配
+func ListEnabled(ctx context.Context, db *gorm.DB, chainID int32, contractAddress string) ([]*model.WebhookSubscription, error) {
+	var res []*model.WebhookSubscription
+	if err := db.WithContext(ctx).
+		Where("enabled = ? AND chain_id IN ? AND contract_address IN ?", true, []int32{0, chainID}, []string{"", contractAddress}).
+		Order("id ASC").
+		Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func SetEnabled(ctx context.Context, db *gorm.DB, id int64, enabled bool) (int64, error) {
+	res := db.WithContext(ctx).Model(&model.WebhookSubscription{}).Where("id = ?", id).Update("enabled", enabled)
+	return res.RowsAffected, res.Error
+}
+
+func Delete(ctx context.Context, db *gorm.DB, id int64) (int64, error) {
+	res := db.WithContext(ctx).Where("id = ?", id).Delete(&model.WebhookSubscription{})
+	return res.RowsAffected, res.Error
+}
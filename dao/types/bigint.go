@@ -0,0 +1,153 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BigInt 任意精度整数，对应 DECIMAL(65,0) 金额列，保存链上 uint256 的原始最小单位（不做精度换算）。
+// 读写数据库和 JSON 时均使用十进制字符串，保证金额精确往返；值不可变，零值表示 0
+type BigInt struct {
+	v *big.Int
+}
+
+// NewBigInt 复制 v 构造 BigInt，nil 视为 0
+func NewBigInt(v *big.Int) BigInt {
+	if v == nil {
+		return BigInt{}
+	}
+	return BigInt{v: new(big.Int).Set(v)}
+}
+
+// NewBigIntFromInt64 由 int64 构造 BigInt
+func NewBigIntFromInt64(v int64) BigInt {
+	return BigInt{v: big.NewInt(v)}
+}
+
+// ParseBigInt 解析十进制字符串，允许 DECIMAL 列返回的全零小数部分（如 "12.000"）
+func ParseBigInt(s string) (BigInt, error) {
+	s = strings.TrimSpace(s)
+	if intPart, frac, ok := strings.Cut(s, "."); ok {
+		if strings.Trim(frac, "0") != "" {
+			return BigInt{}, fmt.Errorf("types.BigInt: %q has a fractional part", s)
+		}
+		s = intPart
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return BigInt{}, fmt.Errorf("types.BigInt: invalid integer %q", s)
+	}
+	return BigInt{v: v}, nil
+}
+
+// Big 返回 big.Int 副本，修改返回值不影响 b
+func (b BigInt) Big() *big.Int {
+	if b.v == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(b.v)
+}
+
+func (b BigInt) Sign() int {
+	if b.v == nil {
+		return 0
+	}
+	return b.v.Sign()
+}
+
+// Cmp 比较 b 和 o，返回 -1、0、1
+func (b BigInt) Cmp(o BigInt) int {
+	return b.Big().Cmp(o.Big())
+}
+
+func (b BigInt) Add(o BigInt) BigInt {
+	return BigInt{v: new(big.Int).Add(b.Big(), o.Big())}
+}
+
+func (b BigInt) Neg() BigInt {
+	return BigInt{v: new(big.Int).Neg(b.Big())}
+}
+
+func (b BigInt) String() string {
+	if b.v == nil {
+		return "0"
+	}
+	return b.v.String()
+}
+
+// Scan 实现 sql.Scanner，MySQL 驱动以 []byte 返回 DECIMAL
+func (b *BigInt) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*b = BigInt{}
+		return nil
+	case []byte:
+		res, err := ParseBigInt(string(v))
+		if err != nil {
+			return err
+		}
+		*b = res
+		return nil
+	case string:
+		res, err := ParseBigInt(v)
+		if err != nil {
+			return err
+		}
+		*b = res
+		return nil
+	case int64:
+		*b = NewBigIntFromInt64(v)
+		return nil
+	case float64:
+		f := new(big.Float).SetFloat64(v)
+		if !f.IsInt() {
+			return fmt.Errorf("types.BigInt: %v has a fractional part", v)
+		}
+		i, _ := f.Int(nil)
+		*b = BigInt{v: i}
+		return nil
+	default:
+		return fmt.Errorf("types.BigInt: cannot scan %T", src)
+	}
+}
+
+// Value 实现 driver.Valuer，以十进制字符串写入，避免驱动转换为浮点数
+func (b BigInt) Value() (driver.Value, error) {
+	return b.String(), nil
+}
+
+// MarshalJSON 输出为 JSON 字符串，避免 JavaScript 等客户端按 float64 解析丢失精度
+func (b BigInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON 同时接受 JSON 字符串和数字
+func (b *BigInt) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	res, err := ParseBigInt(s)
+	if err != nil {
+		return err
+	}
+	*b = res
+	return nil
+}
+
+// IncrExpr 生成 column + delta 的更新表达式。参数以字符串传入，MySQL 会把字符串参与的加法按 DOUBLE 计算，
+// 因此先 CAST 为 DECIMAL(65,0) 保证精确
+func IncrExpr(column string, delta BigInt) clause.Expr {
+	return gorm.Expr(column+" + CAST(? AS DECIMAL(65,0))", delta)
+}
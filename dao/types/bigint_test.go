@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+// maxUint256 超出 float64 和 int64 精度的金额
+const maxUint256 = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+
+func TestBigIntScan(t *testing.T) {
+	tests := []struct {
+		src     interface{}
+		want    string
+		wantErr bool
+	}{
+		{src: nil, want: "0"},
+		{src: []byte(maxUint256), want: maxUint256},
+		{src: []byte("12.000"), want: "12"},
+		{src: "-42", want: "-42"},
+		{src: " 7 ", want: "7"},
+		{src: int64(123), want: "123"},
+		{src: float64(1e20), want: "100000000000000000000"},
+		{src: []byte("12.5"), wantErr: true},
+		{src: "abc", wantErr: true},
+		{src: float64(1.5), wantErr: true},
+		{src: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		b := NewBigIntFromInt64(99)
+		err := b.Scan(tt.src)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Scan(%#v) error = %v, wantErr %v", tt.src, err, tt.wantErr)
+		}
+		if !tt.wantErr && b.String() != tt.want {
+			t.Fatalf("Scan(%#v) = %s, want %s", tt.src, b, tt.want)
+		}
+	}
+}
+
+func TestBigIntValue(t *testing.T) {
+	v, _ := new(big.Int).SetString(maxUint256, 10)
+	for _, tt := range []struct {
+		b    BigInt
+		want string
+	}{
+		{BigInt{}, "0"},
+		{NewBigInt(nil), "0"},
+		{NewBigInt(v), maxUint256},
+		{NewBigIntFromInt64(-5), "-5"},
+	} {
+		got, err := tt.b.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 以字符串写入，驱动不会转换为浮点数
+		if s, ok := got.(string); !ok || s != tt.want {
+			t.Fatalf("Value() = %#v, want %q", got, tt.want)
+		}
+	}
+
+	// NewBigInt 复制参数，之后修改参数不影响已构造的值
+	src := big.NewInt(10)
+	b := NewBigInt(src)
+	src.SetInt64(20)
+	if b.String() != "10" {
+		t.Fatalf("NewBigInt after modifying source = %s, want 10", b)
+	}
+}
+
+func TestBigIntJSON(t *testing.T) {
+	type payload struct {
+		Amount BigInt  `json:"amount"`
+		Opt    *BigInt `json:"opt"`
+	}
+	v, _ := new(big.Int).SetString(maxUint256, 10)
+	amount := NewBigInt(v)
+	data, err := json.Marshal(payload{Amount: amount})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"amount":"` + maxUint256 + `","opt":null}`; string(data) != want {
+		t.Fatalf("Marshal = %s, want %s", data, want)
+	}
+
+	var got payload
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Amount.Cmp(amount) != 0 || got.Opt != nil {
+		t.Fatalf("round trip = %s, %v, want %s, nil", got.Amount, got.Opt, amount)
+	}
+
+	for _, tt := range []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: `{"amount":123}`, want: "123"},
+		{in: `{"amount":"456"}`, want: "456"},
+		{in: `{"amount":null}`, want: "0"},
+		{in: `{"amount":` + maxUint256 + `}`, want: maxUint256},
+		{in: `{"amount":1.5}`, wantErr: true},
+		{in: `{"amount":"x"}`, wantErr: true},
+	} {
+		var p payload
+		err := json.Unmarshal([]byte(tt.in), &p)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("Unmarshal(%s) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+		}
+		if !tt.wantErr && p.Amount.String() != tt.want {
+			t.Fatalf("Unmarshal(%s) = %s, want %s", tt.in, p.Amount, tt.want)
+		}
+	}
+}
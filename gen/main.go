@@ -26,12 +26,47 @@ func main() {
 
 	g.UseDB(gormdb)
 
+	// DECIMAL 金额列映射为 types.BigInt（按链上原始最小单位精确存储），可空列由 FieldNullable 生成指针
+	g.WithDataTypeMap(map[string]func(columnType gorm.ColumnType) (dataType string){
+		"decimal": func(columnType gorm.ColumnType) string { return "types.BigInt" },
+	})
+	g.WithImportPkgPath("github.com/dijiacoder/MetaNodeStakeSync/dao/types")
+
 	// 已有的表模型生成
 	g.ApplyBasic(
 		g.GenerateModel("chain_contracts"),
 		g.GenerateModel("chain_endpoints"),
 		g.GenerateModel("contract_events"),
 		g.GenerateModel("pool_info"),
+		g.GenerateModel("user_pool_stats"),
+		g.GenerateModel("event_deposit"),
+		g.GenerateModel("event_request_unstake"),
+		g.GenerateModel("user_unstake_requests"),
+		g.GenerateModel("event_withdraw"),
+		g.GenerateModel("event_claim"),
+		g.GenerateModel("event_update_pool_info"),
+		g.GenerateModel("event_set_pool_weight"),
+		g.GenerateModel("event_update_pool"),
+		g.GenerateModel("stake_contract_state"),
+		g.GenerateModel("stake_contract_state_history"),
+		g.GenerateModel("event_set_metanode"),
+		g.GenerateModel("event_set_start_block"),
+		g.GenerateModel("event_set_end_block"),
+		g.GenerateModel("event_set_metanode_per_block"),
+		g.GenerateModel("event_pause_withdraw"),
+		g.GenerateModel("event_pause_claim"),
+		g.GenerateModel("event_paused"),
+		g.GenerateModel("sync_blocks"),
+		g.GenerateModel("reorg_snapshots"),
+		g.GenerateModel("sync_status"),
+		g.GenerateModel("sync_leases"),
+		g.GenerateModel("failed_events"),
+		g.GenerateModel("event_outbox"),
+		g.GenerateModel("webhook_subscriptions"),
+		g.GenerateModel("webhook_deliveries"),
+		g.GenerateModel("schema_migrations"),
+		g.GenerateModel("token_transfers"),
+		g.GenerateModel("token_balances"),
 	)
 
 	g.Execute()
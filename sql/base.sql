@@ -4,10 +4,12 @@
 CREATE TABLE IF NOT EXISTS chain_contracts (
                                                id BIGINT PRIMARY KEY AUTO_INCREMENT,
                                                chain_id INT NOT NULL COMMENT '链ID (如 1 for Ethereum Mainnet, 11155111 for Sepolia)',
-                                               contract_name INT NOT NULL COMMENT '合约名称标识符 (1 - stake contract)',
+                                               contract_name INT NOT NULL COMMENT '合约名称标识符 (1 - stake contract, 2 - MetaNode ERC20 token)',
                                                contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
     created_tx_hash VARCHAR(66) COMMENT '创建交易哈希',
-    abi TEXT NOT NULL COMMENT '合约ABI',
+    abi TEXT NOT NULL COMMENT '合约ABI (为空时使用处理模块内置的ABI)',
+    sync_mode VARCHAR(16) NOT NULL DEFAULT 'latest' COMMENT '同步高度模式 (latest / confirmations / safe / finalized)',
+    confirmations INT NOT NULL DEFAULT 0 COMMENT 'confirmations 模式下落后最新区块的确认数',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     INDEX idx_chain_id (chain_id),
@@ -20,12 +22,15 @@ CREATE TABLE IF NOT EXISTS chain_contracts (
 -- ========================================
 CREATE TABLE IF NOT EXISTS chain_endpoints (
                                                id BIGINT PRIMARY KEY AUTO_INCREMENT,
-                                               chain_id INT NOT NULL UNIQUE COMMENT '链ID',
+                                               chain_id INT NOT NULL COMMENT '链ID',
                                                url VARCHAR(255) NOT NULL COMMENT 'RPC端点URL',
+    priority INT NOT NULL DEFAULT 0 COMMENT '优先级，越小越优先',
+    rate_limit INT NOT NULL DEFAULT 0 COMMENT '每秒最多请求数，0 表示不限制',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_chain_id (chain_id)
-    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='链端点信息表';
+    UNIQUE KEY uk_chain_url (chain_id, url),
+    INDEX idx_chain_priority (chain_id, priority)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='链端点信息表 (同一条链可配置多个端点)';
 
 -- ========================================
 -- 初始化数据示例
@@ -1,6 +1,7 @@
 -- ========================================
 -- MetaNodeStake 合约事件同步数据库表结构
 -- 数据库版本: MySQL 8.x
+-- 金额列（DECIMAL(65,0)）均为链上原始最小单位的整数，不做精度换算
 -- ========================================
 
 -- 删除已存在的表（开发环境使用，生产环境请注释）
@@ -17,7 +18,18 @@
 -- DROP TABLE IF EXISTS event_set_start_block;
 -- DROP TABLE IF EXISTS event_pause_claim;
 -- DROP TABLE IF EXISTS event_pause_withdraw;
+-- DROP TABLE IF EXISTS event_paused;
 -- DROP TABLE IF EXISTS event_set_metanode;
+-- DROP TABLE IF EXISTS schema_migrations;
+-- DROP TABLE IF EXISTS sync_leases;
+-- DROP TABLE IF EXISTS webhook_deliveries;
+-- DROP TABLE IF EXISTS webhook_subscriptions;
+-- DROP TABLE IF EXISTS event_outbox;
+-- DROP TABLE IF EXISTS failed_events;
+-- DROP TABLE IF EXISTS reorg_snapshots;
+-- DROP TABLE IF EXISTS sync_blocks;
+-- DROP TABLE IF EXISTS stake_contract_state_history;
+-- DROP TABLE IF EXISTS stake_contract_state;
 -- DROP TABLE IF EXISTS user_pool_stats;
 -- DROP TABLE IF EXISTS pool_info;
 -- DROP TABLE IF EXISTS sync_status;
@@ -43,14 +55,15 @@ CREATE TABLE IF NOT EXISTS sync_status (
 -- ========================================
 CREATE TABLE IF NOT EXISTS pool_info (
                                          id INT PRIMARY KEY AUTO_INCREMENT,
+                                         chain_id INT NOT NULL COMMENT '链ID',
                                          pool_id INT NOT NULL COMMENT '资金池ID',
                                          contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
     st_token_address VARCHAR(42) NOT NULL COMMENT '质押代币地址 (0x0 表示ETH)',
     pool_weight DECIMAL(30,0) NOT NULL COMMENT '资金池权重',
     last_reward_block BIGINT UNSIGNED NOT NULL COMMENT '最后奖励区块',
-    acc_metanode_per_st DECIMAL(65,18) DEFAULT 0 COMMENT '每质押代币累计MetaNode',
-    st_token_amount DECIMAL(65,18) DEFAULT 0 COMMENT '质押代币总量',
-    min_deposit_amount DECIMAL(65,18) NOT NULL COMMENT '最小质押金额',
+    acc_metanode_per_st DECIMAL(65,0) DEFAULT 0 COMMENT '每质押代币累计MetaNode',
+    st_token_amount DECIMAL(65,0) DEFAULT 0 COMMENT '质押代币总量',
+    min_deposit_amount DECIMAL(65,0) NOT NULL COMMENT '最小质押金额',
     unstake_locked_blocks INT NOT NULL COMMENT '解锁区块数',
     total_pool_weight DECIMAL(30,0) COMMENT '所有池的总权重',
     is_active BOOLEAN DEFAULT TRUE COMMENT '是否激活',
@@ -58,7 +71,7 @@ CREATE TABLE IF NOT EXISTS pool_info (
     created_tx VARCHAR(66) COMMENT '创建交易哈希',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_pool_contract (pool_id, contract_address),
+    UNIQUE KEY uk_pool_contract (chain_id, pool_id, contract_address),
     INDEX idx_st_token (st_token_address),
     INDEX idx_contract (contract_address)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='资金池信息表';
@@ -68,21 +81,23 @@ CREATE TABLE IF NOT EXISTS pool_info (
 -- ========================================
 CREATE TABLE IF NOT EXISTS user_pool_stats (
                                                id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                               chain_id INT NOT NULL COMMENT '链ID',
                                                user_address VARCHAR(42) NOT NULL COMMENT '用户地址',
     pool_id INT NOT NULL COMMENT '资金池ID',
     contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
-    st_amount DECIMAL(65,18) DEFAULT 0 COMMENT '当前质押金额',
-    finished_metanode DECIMAL(65,18) DEFAULT 0 COMMENT '已领取的MetaNode',
-    pending_metanode DECIMAL(65,18) DEFAULT 0 COMMENT '待领取的MetaNode',
-    total_deposited DECIMAL(65,18) DEFAULT 0 COMMENT '累计质押金额',
-    total_unstaked DECIMAL(65,18) DEFAULT 0 COMMENT '累计解质押金额',
-    total_withdrawn DECIMAL(65,18) DEFAULT 0 COMMENT '累计提现金额',
-    total_claimed DECIMAL(65,18) DEFAULT 0 COMMENT '累计领取奖励',
+    st_amount DECIMAL(65,0) DEFAULT 0 COMMENT '当前质押金额',
+    finished_metanode DECIMAL(65,0) DEFAULT 0 COMMENT '已领取的MetaNode',
+    pending_metanode DECIMAL(65,0) DEFAULT 0 COMMENT '待领取的MetaNode',
+    settled_metanode DECIMAL(65,0) DEFAULT 0 COMMENT '上次存入、解质押或领取时结算的待领取MetaNode',
+    total_deposited DECIMAL(65,0) DEFAULT 0 COMMENT '累计质押金额',
+    total_unstaked DECIMAL(65,0) DEFAULT 0 COMMENT '累计解质押金额',
+    total_withdrawn DECIMAL(65,0) DEFAULT 0 COMMENT '累计提现金额',
+    total_claimed DECIMAL(65,0) DEFAULT 0 COMMENT '累计领取奖励',
     last_deposit_block BIGINT UNSIGNED COMMENT '最后质押区块',
     last_claim_block BIGINT UNSIGNED COMMENT '最后领取区块',
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_user_pool (user_address, pool_id, contract_address),
+    UNIQUE KEY uk_user_pool (chain_id, user_address, pool_id, contract_address),
     INDEX idx_user (user_address),
     INDEX idx_pool (pool_id),
     INDEX idx_st_amount (st_amount)
@@ -95,6 +110,7 @@ CREATE TABLE IF NOT EXISTS user_pool_stats (
 -- 4. SetMetaNode 事件
 CREATE TABLE IF NOT EXISTS event_set_metanode (
                                                   id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                  chain_id INT NOT NULL COMMENT '链ID',
                                                   contract_address VARCHAR(42) NOT NULL,
     metanode_token VARCHAR(42) NOT NULL COMMENT 'MetaNode代币地址',
     block_number BIGINT UNSIGNED NOT NULL,
@@ -102,7 +118,7 @@ CREATE TABLE IF NOT EXISTS event_set_metanode (
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_contract (contract_address)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='SetMetaNode事件表';
@@ -110,6 +126,7 @@ CREATE TABLE IF NOT EXISTS event_set_metanode (
 -- 5. PauseWithdraw / UnpauseWithdraw 事件
 CREATE TABLE IF NOT EXISTS event_pause_withdraw (
                                                     id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                    chain_id INT NOT NULL COMMENT '链ID',
                                                     contract_address VARCHAR(42) NOT NULL,
     is_paused BOOLEAN NOT NULL COMMENT '是否暂停 (true=暂停, false=恢复)',
     block_number BIGINT UNSIGNED NOT NULL,
@@ -117,7 +134,7 @@ CREATE TABLE IF NOT EXISTS event_pause_withdraw (
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_contract (contract_address)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='暂停/恢复提现事件表';
@@ -125,6 +142,7 @@ CREATE TABLE IF NOT EXISTS event_pause_withdraw (
 -- 6. PauseClaim / UnpauseClaim 事件
 CREATE TABLE IF NOT EXISTS event_pause_claim (
                                                  id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                 chain_id INT NOT NULL COMMENT '链ID',
                                                  contract_address VARCHAR(42) NOT NULL,
     is_paused BOOLEAN NOT NULL COMMENT '是否暂停 (true=暂停, false=恢复)',
     block_number BIGINT UNSIGNED NOT NULL,
@@ -132,14 +150,32 @@ CREATE TABLE IF NOT EXISTS event_pause_claim (
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_contract (contract_address)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='暂停/恢复领取事件表';
 
+-- 6.1 Paused / Unpaused 事件 (OpenZeppelin PausableUpgradeable)
+CREATE TABLE IF NOT EXISTS event_paused (
+                                            id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                            chain_id INT NOT NULL COMMENT '链ID',
+                                            contract_address VARCHAR(42) NOT NULL,
+    is_paused BOOLEAN NOT NULL COMMENT '是否暂停 (true=暂停, false=恢复)',
+    account VARCHAR(42) NOT NULL COMMENT '触发暂停/恢复的账户',
+    block_number BIGINT UNSIGNED NOT NULL,
+    block_timestamp BIGINT UNSIGNED NOT NULL,
+    transaction_hash VARCHAR(66) NOT NULL,
+    log_index INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
+    INDEX idx_block (block_number),
+    INDEX idx_contract (contract_address)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='合约暂停/恢复事件表';
+
 -- 7. SetStartBlock 事件
 CREATE TABLE IF NOT EXISTS event_set_start_block (
                                                      id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                     chain_id INT NOT NULL COMMENT '链ID',
                                                      contract_address VARCHAR(42) NOT NULL,
     start_block BIGINT UNSIGNED NOT NULL COMMENT '质押开始区块',
     block_number BIGINT UNSIGNED NOT NULL,
@@ -147,7 +183,7 @@ CREATE TABLE IF NOT EXISTS event_set_start_block (
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_contract (contract_address)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='设置开始区块事件表';
@@ -155,6 +191,7 @@ CREATE TABLE IF NOT EXISTS event_set_start_block (
 -- 8. SetEndBlock 事件
 CREATE TABLE IF NOT EXISTS event_set_end_block (
                                                    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                   chain_id INT NOT NULL COMMENT '链ID',
                                                    contract_address VARCHAR(42) NOT NULL,
     end_block BIGINT UNSIGNED NOT NULL COMMENT '质押结束区块',
     block_number BIGINT UNSIGNED NOT NULL,
@@ -162,7 +199,7 @@ CREATE TABLE IF NOT EXISTS event_set_end_block (
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_contract (contract_address)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='设置结束区块事件表';
@@ -170,14 +207,15 @@ CREATE TABLE IF NOT EXISTS event_set_end_block (
 -- 9. SetMetaNodePerBlock 事件
 CREATE TABLE IF NOT EXISTS event_set_metanode_per_block (
                                                             id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                            chain_id INT NOT NULL COMMENT '链ID',
                                                             contract_address VARCHAR(42) NOT NULL,
-    metanode_per_block DECIMAL(65,18) NOT NULL COMMENT '每区块MetaNode奖励',
+    metanode_per_block DECIMAL(65,0) NOT NULL COMMENT '每区块MetaNode奖励',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_contract (contract_address)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='设置每区块奖励事件表';
@@ -185,19 +223,20 @@ CREATE TABLE IF NOT EXISTS event_set_metanode_per_block (
 -- 10. AddPool 事件
 CREATE TABLE IF NOT EXISTS event_add_pool (
                                               id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                              chain_id INT NOT NULL COMMENT '链ID',
                                               contract_address VARCHAR(42) NOT NULL,
     pool_id INT NOT NULL COMMENT '资金池ID (根据事件顺序计算)',
     st_token_address VARCHAR(42) NOT NULL COMMENT '质押代币地址',
     pool_weight DECIMAL(30,0) NOT NULL COMMENT '资金池权重',
     last_reward_block BIGINT UNSIGNED NOT NULL COMMENT '最后奖励区块',
-    min_deposit_amount DECIMAL(65,18) NOT NULL COMMENT '最小质押金额',
+    min_deposit_amount DECIMAL(65,0) NOT NULL COMMENT '最小质押金额',
     unstake_locked_blocks INT NOT NULL COMMENT '解锁区块数',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_pool (pool_id),
     INDEX idx_contract (contract_address)
@@ -206,16 +245,17 @@ CREATE TABLE IF NOT EXISTS event_add_pool (
 -- 11. UpdatePoolInfo 事件
 CREATE TABLE IF NOT EXISTS event_update_pool_info (
                                                       id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                      chain_id INT NOT NULL COMMENT '链ID',
                                                       contract_address VARCHAR(42) NOT NULL,
     pool_id INT NOT NULL COMMENT '资金池ID',
-    min_deposit_amount DECIMAL(65,18) NOT NULL COMMENT '最小质押金额',
+    min_deposit_amount DECIMAL(65,0) NOT NULL COMMENT '最小质押金额',
     unstake_locked_blocks INT NOT NULL COMMENT '解锁区块数',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_pool (pool_id),
     INDEX idx_contract (contract_address)
@@ -224,6 +264,7 @@ CREATE TABLE IF NOT EXISTS event_update_pool_info (
 -- 12. SetPoolWeight 事件
 CREATE TABLE IF NOT EXISTS event_set_pool_weight (
                                                      id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                     chain_id INT NOT NULL COMMENT '链ID',
                                                      contract_address VARCHAR(42) NOT NULL,
     pool_id INT NOT NULL COMMENT '资金池ID',
     pool_weight DECIMAL(30,0) NOT NULL COMMENT '新的资金池权重',
@@ -233,7 +274,7 @@ CREATE TABLE IF NOT EXISTS event_set_pool_weight (
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_pool (pool_id),
     INDEX idx_contract (contract_address)
@@ -242,16 +283,17 @@ CREATE TABLE IF NOT EXISTS event_set_pool_weight (
 -- 13. UpdatePool 事件
 CREATE TABLE IF NOT EXISTS event_update_pool (
                                                  id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                 chain_id INT NOT NULL COMMENT '链ID',
                                                  contract_address VARCHAR(42) NOT NULL,
     pool_id INT NOT NULL COMMENT '资金池ID',
     last_reward_block BIGINT UNSIGNED NOT NULL COMMENT '最后奖励区块',
-    total_metanode DECIMAL(65,18) NOT NULL COMMENT '本次更新的总MetaNode奖励',
+    total_metanode DECIMAL(65,0) NOT NULL COMMENT '本次更新的总MetaNode奖励',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_pool (pool_id),
     INDEX idx_contract (contract_address)
@@ -260,16 +302,17 @@ CREATE TABLE IF NOT EXISTS event_update_pool (
 -- 14. Deposit 事件 (最重要的用户操作事件之一)
 CREATE TABLE IF NOT EXISTS event_deposit (
                                              id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                             chain_id INT NOT NULL COMMENT '链ID',
                                              contract_address VARCHAR(42) NOT NULL,
     user_address VARCHAR(42) NOT NULL COMMENT '用户地址',
     pool_id INT NOT NULL COMMENT '资金池ID',
-    amount DECIMAL(65,18) NOT NULL COMMENT '质押金额',
+    amount DECIMAL(65,0) NOT NULL COMMENT '质押金额',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_user (user_address),
     INDEX idx_pool (pool_id),
@@ -280,16 +323,17 @@ CREATE TABLE IF NOT EXISTS event_deposit (
 -- 15. RequestUnstake 事件
 CREATE TABLE IF NOT EXISTS event_request_unstake (
                                                      id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                     chain_id INT NOT NULL COMMENT '链ID',
                                                      contract_address VARCHAR(42) NOT NULL,
     user_address VARCHAR(42) NOT NULL COMMENT '用户地址',
     pool_id INT NOT NULL COMMENT '资金池ID',
-    amount DECIMAL(65,18) NOT NULL COMMENT '解质押金额',
+    amount DECIMAL(65,0) NOT NULL COMMENT '解质押金额',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_user (user_address),
     INDEX idx_pool (pool_id),
@@ -300,37 +344,42 @@ CREATE TABLE IF NOT EXISTS event_request_unstake (
 -- 16. Withdraw 事件
 CREATE TABLE IF NOT EXISTS event_withdraw (
                                               id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                              chain_id INT NOT NULL COMMENT '链ID',
                                               contract_address VARCHAR(42) NOT NULL,
     user_address VARCHAR(42) NOT NULL COMMENT '用户地址',
     pool_id INT NOT NULL COMMENT '资金池ID',
-    amount DECIMAL(65,18) NOT NULL COMMENT '提现金额',
+    amount DECIMAL(65,0) NOT NULL COMMENT '提现金额',
     withdraw_block_number BIGINT UNSIGNED NOT NULL COMMENT '提现时的区块号',
+    matched_amount DECIMAL(65,0) NOT NULL DEFAULT 0 COMMENT '按FIFO匹配到的已解锁请求金额合计',
+    amount_mismatch BOOLEAN NOT NULL DEFAULT FALSE COMMENT '提现金额与匹配请求金额是否不一致',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_user (user_address),
     INDEX idx_pool (pool_id),
     INDEX idx_user_pool (user_address, pool_id),
-    INDEX idx_contract (contract_address)
+    INDEX idx_contract (contract_address),
+    INDEX idx_amount_mismatch (amount_mismatch)
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='提现事件表';
 
 -- 17. Claim 事件 (最重要的用户操作事件之一)
 CREATE TABLE IF NOT EXISTS event_claim (
                                            id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                           chain_id INT NOT NULL COMMENT '链ID',
                                            contract_address VARCHAR(42) NOT NULL,
     user_address VARCHAR(42) NOT NULL COMMENT '用户地址',
     pool_id INT NOT NULL COMMENT '资金池ID',
-    metanode_reward DECIMAL(65,18) NOT NULL COMMENT '领取的MetaNode奖励',
+    metanode_reward DECIMAL(65,0) NOT NULL COMMENT '领取的MetaNode奖励',
     block_number BIGINT UNSIGNED NOT NULL,
     block_timestamp BIGINT UNSIGNED NOT NULL,
     transaction_hash VARCHAR(66) NOT NULL,
     log_index INT NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    UNIQUE KEY uk_tx_log (transaction_hash, log_index),
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
     INDEX idx_block (block_number),
     INDEX idx_user (user_address),
     INDEX idx_pool (pool_id),
@@ -343,10 +392,11 @@ CREATE TABLE IF NOT EXISTS event_claim (
 -- ========================================
 CREATE TABLE IF NOT EXISTS user_unstake_requests (
                                                      id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                     chain_id INT NOT NULL COMMENT '链ID',
                                                      user_address VARCHAR(42) NOT NULL COMMENT '用户地址',
     pool_id INT NOT NULL COMMENT '资金池ID',
     contract_address VARCHAR(42) NOT NULL,
-    amount DECIMAL(65,18) NOT NULL COMMENT '解质押金额',
+    amount DECIMAL(65,0) NOT NULL COMMENT '解质押金额',
     unlock_block BIGINT UNSIGNED NOT NULL COMMENT '解锁区块号',
     request_block BIGINT UNSIGNED NOT NULL COMMENT '申请时的区块号',
     request_tx VARCHAR(66) NOT NULL COMMENT '申请交易哈希',
@@ -362,12 +412,195 @@ CREATE TABLE IF NOT EXISTS user_unstake_requests (
     ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户解质押请求表';
 
 -- ========================================
--- 19. 统计视图 - 便于查询
+-- 19. 合约全局参数表 - 存储合约级参数的最新状态
+-- ========================================
+CREATE TABLE IF NOT EXISTS stake_contract_state (
+                                                    id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                    chain_id INT NOT NULL COMMENT '链ID',
+                                                    contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    metanode_token VARCHAR(42) COMMENT 'MetaNode代币地址',
+    start_block BIGINT UNSIGNED COMMENT '质押开始区块',
+    end_block BIGINT UNSIGNED COMMENT '质押结束区块',
+    metanode_per_block DECIMAL(65,0) COMMENT '每区块MetaNode奖励',
+    withdraw_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否暂停提现',
+    claim_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否暂停领取',
+    paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '合约是否整体暂停 (OpenZeppelin Pausable)',
+    last_updated_block BIGINT UNSIGNED COMMENT '参数最后变更的区块号',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_contract (chain_id, contract_address)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='合约全局参数表';
+
+-- ========================================
+-- 20. 已同步区块哈希表 - 用于链重组检测
+-- ========================================
+CREATE TABLE IF NOT EXISTS sync_blocks (
+                                           id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                           chain_id INT NOT NULL COMMENT '链ID',
+                                           contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    block_number BIGINT UNSIGNED NOT NULL COMMENT '区块号',
+    block_hash VARCHAR(66) NOT NULL COMMENT '区块哈希',
+    parent_hash VARCHAR(66) NOT NULL COMMENT '父区块哈希',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_chain_contract_block (chain_id, contract_address, block_number)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='已同步区块哈希表';
+
+-- ========================================
+-- 21. 派生表回滚快照表 - 链重组时恢复 pool_info / user_pool_stats 等派生状态
+-- ========================================
+CREATE TABLE IF NOT EXISTS reorg_snapshots (
+                                               id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                               chain_id INT NOT NULL COMMENT '链ID',
+                                               contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    block_number BIGINT UNSIGNED NOT NULL COMMENT '快照对应的区块号（处理该区块前的状态）',
+    target_table VARCHAR(64) NOT NULL COMMENT '快照的表名',
+    scope VARCHAR(42) NOT NULL COMMENT '快照范围（用户地址，合约级为空字符串）',
+    snapshot_data LONGTEXT NOT NULL COMMENT '快照行数据 (JSON数组)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_contract_block_scope (chain_id, contract_address, block_number, target_table, scope)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='派生表回滚快照表';
+
+-- ========================================
+-- 22. 处理失败事件表 - 死信队列，记录 handler 连续处理失败的日志，存在待处理记录时暂停该合约的同步
+-- ========================================
+CREATE TABLE IF NOT EXISTS failed_events (
+                                             id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                             chain_id INT NOT NULL COMMENT '链ID',
+                                             contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    event_name VARCHAR(100) NOT NULL COMMENT '事件名称',
+    handler_name VARCHAR(100) NOT NULL COMMENT '处理函数名称',
+    block_number BIGINT UNSIGNED NOT NULL COMMENT '区块号',
+    transaction_hash VARCHAR(66) NOT NULL COMMENT '交易哈希',
+    log_index INT NOT NULL COMMENT '日志序号',
+    raw_log TEXT NOT NULL COMMENT '原始日志 (JSON)',
+    error_message TEXT NOT NULL COMMENT '最近一次处理错误',
+    attempts INT NOT NULL DEFAULT 1 COMMENT '已尝试处理次数',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' COMMENT '状态 (pending / retrying / resolved / dropped)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_tx_log (chain_id, transaction_hash, log_index),
+    INDEX idx_contract_status (contract_address, status),
+    INDEX idx_block (block_number)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='处理失败事件表 (死信队列)';
+
+-- ========================================
+-- 23. 表结构迁移记录表 - 记录各合约处理模块已执行的迁移
+-- ========================================
+CREATE TABLE IF NOT EXISTS schema_migrations (
+                                                 id INT PRIMARY KEY AUTO_INCREMENT,
+                                                 module VARCHAR(64) NOT NULL COMMENT '模块名称',
+    version INT NOT NULL COMMENT '迁移版本',
+    description VARCHAR(255) NOT NULL DEFAULT '' COMMENT '迁移说明',
+    applied_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '执行时间',
+    UNIQUE KEY uk_module_version (module, version)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='表结构迁移记录表';
+
+-- ========================================
+-- 24. 事件发件箱表 - 与 contract_events 在同一事务中写入，由 relay 按合约顺序发布到消息总线
+-- ========================================
+CREATE TABLE IF NOT EXISTS event_outbox (
+                                            id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                            chain_id INT NOT NULL COMMENT '链ID',
+                                            contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    kind VARCHAR(16) NOT NULL COMMENT '消息类型 (event / retract)',
+    event_name VARCHAR(100) NOT NULL COMMENT '事件名称',
+    block_number BIGINT UNSIGNED NOT NULL COMMENT '区块号',
+    transaction_hash VARCHAR(66) NOT NULL COMMENT '交易哈希',
+    log_index INT NOT NULL COMMENT '日志序号',
+    payload TEXT NOT NULL COMMENT '消息内容 (JSON)',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '发布失败次数',
+    last_error TEXT COMMENT '最近一次发布错误',
+    published_at TIMESTAMP NULL COMMENT '发布时间，未发布为 NULL',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_contract_published (contract_address, published_at)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='事件发件箱表';
+
+-- ========================================
+-- 25. Webhook 订阅表 - 按事件过滤条件把同步的事件推送到外部 HTTP 地址
+-- ========================================
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+                                                     id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                     name VARCHAR(100) NOT NULL COMMENT '订阅名称',
+    url VARCHAR(500) NOT NULL COMMENT '投递地址',
+    secret VARCHAR(128) NOT NULL COMMENT 'HMAC 签名密钥',
+    format VARCHAR(16) NOT NULL DEFAULT 'json' COMMENT '消息格式 (json / slack)',
+    chain_id INT NOT NULL DEFAULT 0 COMMENT '链ID，0 表示不限',
+    contract_address VARCHAR(42) NOT NULL DEFAULT '' COMMENT '合约地址，空表示不限',
+    events VARCHAR(500) NOT NULL DEFAULT '' COMMENT '事件名称模式，逗号分隔，支持 * 通配，空表示全部事件',
+    pool_id INT NULL COMMENT '只匹配该资金池的事件，NULL 表示不限',
+    min_amount DECIMAL(65,0) NULL COMMENT '只匹配 amount 参数不小于该值的事件，NULL 表示不限',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE COMMENT '是否启用',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Webhook 订阅表';
+
+-- ========================================
+-- 26. Webhook 投递记录表 - 投递队列与投递日志，失败时按指数退避重试
+-- ========================================
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+                                                  id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                  subscription_id BIGINT NOT NULL COMMENT '订阅ID',
+                                                  chain_id INT NOT NULL COMMENT '链ID',
+                                                  contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    event_name VARCHAR(100) NOT NULL COMMENT '事件名称',
+    block_number BIGINT UNSIGNED NOT NULL COMMENT '区块号',
+    transaction_hash VARCHAR(66) NOT NULL COMMENT '交易哈希',
+    log_index INT NOT NULL COMMENT '日志序号',
+    payload TEXT NOT NULL COMMENT '事件内容 (JSON)',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending' COMMENT '状态 (pending / delivered / failed)',
+    attempts INT NOT NULL DEFAULT 0 COMMENT '已投递次数',
+    response_status INT NULL COMMENT '最近一次投递的 HTTP 状态码',
+    last_error TEXT COMMENT '最近一次投递错误',
+    next_retry_at TIMESTAMP NULL COMMENT '下次投递时间',
+    delivered_at TIMESTAMP NULL COMMENT '投递成功时间',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_subscription_tx_log (subscription_id, transaction_hash, log_index),
+    INDEX idx_contract_status (contract_address, status, next_retry_at)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='Webhook 投递记录表';
+
+-- ========================================
+-- 27. 同步租约表 - 同一合约同时只有一个进程（daemon 同步任务或 backfill 等命令）写入派生表
+-- ========================================
+CREATE TABLE IF NOT EXISTS sync_leases (
+                                           id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                           chain_id INT NOT NULL COMMENT '链ID',
+                                           contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    owner VARCHAR(128) NOT NULL COMMENT '租约持有者 (主机名-进程号-角色)',
+    expires_at TIMESTAMP NOT NULL COMMENT '租约过期时间，持有者定期续期',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_chain_contract (chain_id, contract_address)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='同步租约表';
+
+-- ========================================
+-- 28. 合约全局参数历史表 - 每次参数或暂停状态变更后记录完整的参数，用于查询任意区块的合约参数
+-- ========================================
+CREATE TABLE IF NOT EXISTS stake_contract_state_history (
+                                                   id BIGINT PRIMARY KEY AUTO_INCREMENT,
+                                                   chain_id INT NOT NULL COMMENT '链ID',
+                                                   contract_address VARCHAR(42) NOT NULL COMMENT '合约地址',
+    block_number BIGINT UNSIGNED NOT NULL COMMENT '参数生效的区块号',
+    metanode_token VARCHAR(42) COMMENT 'MetaNode代币地址',
+    start_block BIGINT UNSIGNED COMMENT '质押开始区块',
+    end_block BIGINT UNSIGNED COMMENT '质押结束区块',
+    metanode_per_block DECIMAL(65,0) COMMENT '每区块MetaNode奖励',
+    withdraw_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否暂停提现',
+    claim_paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否暂停领取',
+    paused BOOLEAN NOT NULL DEFAULT FALSE COMMENT '合约是否整体暂停 (OpenZeppelin Pausable)',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_contract_block (chain_id, contract_address, block_number)
+    ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='合约全局参数历史表';
+
+-- ========================================
+-- 29. 统计视图 - 便于查询
 -- ========================================
 
 -- 用户总览统计视图
 CREATE OR REPLACE VIEW v_user_stats AS
 SELECT
+    u.chain_id,
     u.user_address,
     u.contract_address,
     COUNT(DISTINCT u.pool_id) as pool_count,
@@ -377,23 +610,24 @@ SELECT
     SUM(u.pending_metanode) as total_pending_reward,
     MAX(u.updated_at) as last_activity
 FROM user_pool_stats u
-GROUP BY u.user_address, u.contract_address;
+GROUP BY u.chain_id, u.user_address, u.contract_address;
 
 -- 资金池统计视图
 CREATE OR REPLACE VIEW v_pool_stats AS
 SELECT
+    p.chain_id,
     p.pool_id,
     p.contract_address,
     p.st_token_address,
     p.pool_weight,
     p.st_token_amount as total_staked,
     COUNT(DISTINCT u.user_address) as user_count,
-    (SELECT COUNT(*) FROM event_deposit d WHERE d.pool_id = p.pool_id AND d.contract_address = p.contract_address) as deposit_count,
-    (SELECT SUM(amount) FROM event_deposit d WHERE d.pool_id = p.pool_id AND d.contract_address = p.contract_address) as total_deposits,
-    (SELECT SUM(metanode_reward) FROM event_claim c WHERE c.pool_id = p.pool_id AND c.contract_address = p.contract_address) as total_claimed_rewards
+    (SELECT COUNT(*) FROM event_deposit d WHERE d.chain_id = p.chain_id AND d.pool_id = p.pool_id AND d.contract_address = p.contract_address) as deposit_count,
+    (SELECT SUM(amount) FROM event_deposit d WHERE d.chain_id = p.chain_id AND d.pool_id = p.pool_id AND d.contract_address = p.contract_address) as total_deposits,
+    (SELECT SUM(metanode_reward) FROM event_claim c WHERE c.chain_id = p.chain_id AND c.pool_id = p.pool_id AND c.contract_address = p.contract_address) as total_claimed_rewards
 FROM pool_info p
-         LEFT JOIN user_pool_stats u ON p.pool_id = u.pool_id AND p.contract_address = u.contract_address
-GROUP BY p.pool_id, p.contract_address, p.st_token_address, p.pool_weight, p.st_token_amount;
+         LEFT JOIN user_pool_stats u ON p.chain_id = u.chain_id AND p.pool_id = u.pool_id AND p.contract_address = u.contract_address
+GROUP BY p.chain_id, p.pool_id, p.contract_address, p.st_token_address, p.pool_weight, p.st_token_amount;
 
 -- ========================================
 -- 初始化数据